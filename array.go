@@ -0,0 +1,55 @@
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+)
+
+//ArrayPolicy decide what happen when source length differ from destination array length
+type ArrayPolicy int
+
+const (
+	//Return [ErrLengthMismatch] if length differ
+	ArrayStrict ArrayPolicy = iota
+	//Drop extra source element, shorter source is still an error
+	ArrayTruncate
+	//Fill the rest of destination with zero value, longer source is still an error
+	ArrayZeroPad
+	//Drop extra source element or fill the rest of destination with zero value
+	ArrayFit
+)
+
+//Handle conversion for array dest
+//Element is mirrored into a new array so destination is left untouched on failure
+func _HandleArray(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if sourceKind == reflect.String && ctx.bestEffort && _IsBytes(dest.Type()) {
+		return _HandleStringToBytes(source, dest, destKind, ctx)
+	}
+	if sourceKind != reflect.Slice && sourceKind != reflect.Array {
+		return ctx.NewError(source, dest, "Array", ErrTypeMismatch)
+	}
+	length := source.Len()
+	destLength := dest.Len()
+	policy := ctx.config.arrayPolicy
+	if (length > destLength && policy != ArrayTruncate && policy != ArrayFit) ||
+		(length < destLength && policy != ArrayZeroPad && policy != ArrayFit) {
+		return ctx.NewError(source, dest, "Array", fmt.Errorf("%w, source has %d element while destination has %d", ErrLengthMismatch, length, destLength))
+	}
+	if length > destLength {
+		length = destLength
+	}
+	value := reflect.New(dest.Type()).Elem()
+	for i := 0; i < length; i++ {
+		ctx.PushIndex(i)
+		err := _RecursiveMirror(source.Index(i), value.Index(i), ctx)
+		ctx.Pop()
+		if err != nil {
+			if ctx.Skip(err) {
+				continue
+			}
+			return err
+		}
+	}
+	dest.Set(value)
+	return nil
+}
@@ -0,0 +1,93 @@
+package mirror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ArrayStruct struct {
+	Hash   [4]byte
+	Scores [3]int
+}
+
+func TestArray(t *testing.T) {
+	t.Run("SliceToArray", func(t *testing.T) {
+		source := map[string]interface{}{
+			"Hash":   []interface{}{1, 2, 3, 4},
+			"Scores": []string{"1", "2", "3"},
+		}
+		dest := ArrayStruct{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, ArrayStruct{[4]byte{1, 2, 3, 4}, [3]int{1, 2, 3}}, dest)
+	})
+
+	t.Run("ArrayToSlice", func(t *testing.T) {
+		source := [3]int{1, 2, 3}
+		dest := []string{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, []string{"1", "2", "3"}, dest)
+	})
+
+	t.Run("ArrayToArray", func(t *testing.T) {
+		source := [3]int{1, 2, 3}
+		dest := [3]float64{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, [3]float64{1, 2, 3}, dest)
+	})
+
+	t.Run("ArrayToMap", func(t *testing.T) {
+		source := ArrayStruct{[4]byte{1, 2, 3, 4}, [3]int{1, 2, 3}}
+		dest := map[string]interface{}{}
+		assert.NoError(t, Mirror(&source, &dest))
+		assert.Equal(t, [4]byte{1, 2, 3, 4}, dest["Hash"])
+		result := ArrayStruct{}
+		assert.NoError(t, Mirror(&dest, &result))
+		assert.Equal(t, source, result)
+	})
+
+	t.Run("ElementError", func(t *testing.T) {
+		source := []interface{}{"1", "A", "3"}
+		dest := [3]int{7, 8, 9}
+		err := SmartMirror(&source, &dest, WithCollectErrors())
+		mirrorErrs := MirrorErrors{}
+		if assert.True(t, errors.As(err, &mirrorErrs)) {
+			assert.Equal(t, "[1]", mirrorErrs[0].Path)
+		}
+		assert.Equal(t, [3]int{1, 0, 3}, dest)
+		assert.Error(t, Mirror(&source, &dest))
+		assert.Equal(t, [3]int{1, 0, 3}, dest)
+	})
+}
+
+func TestArrayPolicy(t *testing.T) {
+	longer := []int{1, 2, 3, 4}
+	shorter := []int{1, 2}
+	testCases := []struct {
+		name          string
+		policy        ArrayPolicy
+		longerResult  [3]int
+		longerError   bool
+		shorterResult [3]int
+		shorterError  bool
+	}{
+		{"Strict", ArrayStrict, [3]int{9, 9, 9}, true, [3]int{9, 9, 9}, true},
+		{"Truncate", ArrayTruncate, [3]int{1, 2, 3}, false, [3]int{9, 9, 9}, true},
+		{"ZeroPad", ArrayZeroPad, [3]int{9, 9, 9}, true, [3]int{1, 2, 0}, false},
+		{"Fit", ArrayFit, [3]int{1, 2, 3}, false, [3]int{1, 2, 0}, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := [3]int{9, 9, 9}
+			err := Mirror(&longer, &dest, WithArrayPolicy(testCase.policy))
+			assert.Equal(t, testCase.longerError, errors.Is(err, ErrLengthMismatch))
+			assert.Equal(t, testCase.longerResult, dest)
+
+			dest = [3]int{9, 9, 9}
+			err = Mirror(&shorter, &dest, WithArrayPolicy(testCase.policy))
+			assert.Equal(t, testCase.shorterError, errors.Is(err, ErrLengthMismatch))
+			assert.Equal(t, testCase.shorterResult, dest)
+		})
+	}
+}
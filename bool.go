@@ -1,37 +1,78 @@
 package mirror
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
-	"strconv"
+	"strings"
 )
 
-func _HandleBool(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
-	if !bestEffort {
+//EmptyBoolPolicy decide what happen when empty string is mirrored into bool
+type EmptyBoolPolicy int
+
+const (
+	//Return [ErrParse]
+	EmptyBoolError EmptyBoolPolicy = iota
+	//Set destination to false
+	EmptyBoolFalse
+	//Leave destination untouched
+	EmptyBoolSkip
+)
+
+//Default string accepted as bool, see [WithBoolValues]
+var _DefaultBoolValues = _NewBoolValues(
+	[]string{"1", "t", "true", "y", "yes", "on", "enable", "enabled"},
+	[]string{"0", "f", "false", "n", "no", "off", "disable", "disabled"},
+)
+
+//Index truthy and falsy string by it's lower case form
+func _NewBoolValues(truthy, falsy []string) map[string]bool {
+	values := make(map[string]bool, len(truthy)+len(falsy))
+	for _, value := range truthy {
+		values[strings.ToLower(strings.TrimSpace(value))] = true
+	}
+	for _, value := range falsy {
+		values[strings.ToLower(strings.TrimSpace(value))] = false
+	}
+	return values
+}
+
+func _HandleBool(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if !ctx.bestEffort {
 		if destKind != sourceKind {
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Bool", ErrTypeMismatch)
 		}
 		dest.Set(source)
 	} else {
 		switch sourceKind {
 		case reflect.Bool:
-			dest.Set(source)
-		case reflect.Int:
+			dest.SetBool(source.Bool())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			intVal := source.Int()
 			dest.SetBool(intVal > 0)
-		case reflect.Uint:
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 			uintVal := source.Uint()
 			dest.SetBool(uintVal > 0)
+		case reflect.Float32, reflect.Float64:
+			floatVal := source.Float()
+			dest.SetBool(floatVal > 0)
 		case reflect.String:
-			rawString := source.String()
-			val, err := strconv.ParseBool(rawString)
-			if err != nil {
-				return fmt.Errorf("Failed to mirror String to Bool, err : %s", err.Error())
+			rawString := strings.ToLower(strings.TrimSpace(source.String()))
+			if rawString == "" {
+				switch ctx.config.emptyBool {
+				case EmptyBoolFalse:
+					dest.SetBool(false)
+					return nil
+				case EmptyBoolSkip:
+					return nil
+				}
+			}
+			val, ok := ctx.config.boolValues[rawString]
+			if !ok {
+				return ctx.NewError(source, dest, "Bool", fmt.Errorf("%w, %q is not a known bool value", ErrParse, source.String()))
 			}
 			dest.SetBool(val)
 		default:
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Bool", ErrTypeMismatch)
 		}
 	}
 	return nil
@@ -0,0 +1,91 @@
+package mirror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoolString(t *testing.T) {
+	truthy := []string{"1", "t", "T", "true", "TRUE", "True", "y", "Y", "yes", " Yes ", "on", "ON", "enable", "enabled", "Enabled"}
+	falsy := []string{"0", "f", "F", "false", "FALSE", "n", "N", "no", "NO", "off", "Off", "disable", "disabled", "\tdisabled\n"}
+	for _, value := range truthy {
+		t.Run(value, func(t *testing.T) {
+			dest := false
+			assert.NoError(t, SmartMirror(&value, &dest))
+			assert.True(t, dest)
+		})
+	}
+	for _, value := range falsy {
+		t.Run(value, func(t *testing.T) {
+			dest := true
+			assert.NoError(t, SmartMirror(&value, &dest))
+			assert.False(t, dest)
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		value := "maybe"
+		dest := false
+		assert.True(t, errors.Is(SmartMirror(&value, &dest), ErrParse))
+	})
+}
+
+func TestBoolValues(t *testing.T) {
+	option := WithBoolValues([]string{"Ja", "Oui"}, []string{"Nein", "Non"})
+	testCases := map[string]bool{"ja": true, " OUI": true, "nein": false, "Non": false}
+	for value, expected := range testCases {
+		dest := !expected
+		assert.NoError(t, SmartMirror(&value, &dest, option))
+		assert.Equal(t, expected, dest)
+	}
+	value := "yes"
+	dest := false
+	assert.True(t, errors.Is(SmartMirror(&value, &dest, option), ErrParse))
+}
+
+func TestBoolNumber(t *testing.T) {
+	source := map[string]interface{}{
+		"Float":    1.0,
+		"Zero":     0.0,
+		"Int64":    int64(1),
+		"Uint8":    uint8(0),
+		"Negative": -1.5,
+	}
+	dest := struct {
+		Float    bool
+		Zero     bool
+		Int64    bool
+		Uint8    bool
+		Negative bool
+	}{Zero: true, Uint8: true, Negative: true}
+	assert.NoError(t, SmartMirror(&source, &dest))
+	assert.True(t, dest.Float)
+	assert.False(t, dest.Zero)
+	assert.True(t, dest.Int64)
+	assert.False(t, dest.Uint8)
+	assert.False(t, dest.Negative)
+}
+
+func TestEmptyBool(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   EmptyBoolPolicy
+		expected bool
+		hasError bool
+	}{
+		{"Error", EmptyBoolError, true, true},
+		{"False", EmptyBoolFalse, false, false},
+		{"Skip", EmptyBoolSkip, true, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			source := map[string]interface{}{"Enabled": "  "}
+			dest := struct{ Enabled bool }{true}
+			err := SmartMirror(&source, &dest, WithEmptyBool(testCase.policy))
+			assert.Equal(t, testCase.hasError, errors.Is(err, ErrParse))
+			assert.Equal(t, testCase.expected, dest.Enabled)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package mirror
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+//BytesEncoding decide how byte slice is encoded when it's mirrored into string and back
+type BytesEncoding int
+
+const (
+	//Use the byte as is, ex : "hello"
+	BytesRaw BytesEncoding = iota + 1
+	//Standard base64 with padding, ex : "+/8="
+	BytesBase64
+	//URL safe base64 with padding, ex : "-_8="
+	BytesBase64URL
+	//Lower case hexadecimal, ex : "fbff"
+	BytesHex
+)
+
+func _ParseBytesEncoding(raw string) BytesEncoding {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "raw":
+		return BytesRaw
+	case "base64":
+		return BytesBase64
+	case "base64url":
+		return BytesBase64URL
+	case "hex":
+		return BytesHex
+	}
+	return 0
+}
+
+//Report whether type is a slice or array of byte
+func _IsBytes(valueType reflect.Type) bool {
+	kind := valueType.Kind()
+	return (kind == reflect.Slice || kind == reflect.Array) && valueType.Elem().Kind() == reflect.Uint8
+}
+
+//Get byte encoding of the current value, field tag take precedence over call option
+func (ctx *_MirrorContext) BytesEncoding() BytesEncoding {
+	if tag := ctx.InheritedTag(); tag != nil && tag.encoding != 0 {
+		return tag.encoding
+	}
+	return ctx.config.bytesEncoding
+}
+
+//Encode byte slice or array into string
+func _EncodeBytes(source reflect.Value, encoding BytesEncoding) string {
+	var data []byte
+	if source.Kind() == reflect.Slice {
+		data = source.Bytes()
+	} else {
+		data = make([]byte, source.Len())
+		for i := range data {
+			data[i] = byte(source.Index(i).Uint())
+		}
+	}
+	switch encoding {
+	case BytesBase64:
+		return base64.StdEncoding.EncodeToString(data)
+	case BytesBase64URL:
+		return base64.URLEncoding.EncodeToString(data)
+	case BytesHex:
+		return hex.EncodeToString(data)
+	}
+	return string(data)
+}
+
+//Decode string into byte slice
+func _DecodeBytes(raw string, encoding BytesEncoding) ([]byte, error) {
+	var data []byte
+	var err error
+	switch encoding {
+	case BytesBase64:
+		data, err = base64.StdEncoding.DecodeString(raw)
+	case BytesBase64URL:
+		data, err = base64.URLEncoding.DecodeString(raw)
+	case BytesHex:
+		data, err = hex.DecodeString(raw)
+	default:
+		data = []byte(raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrParse, err.Error())
+	}
+	return data, nil
+}
+
+//Handle conversion from string into byte slice or array
+//Strict mirror always copy the raw byte of the string
+func _HandleStringToBytes(source, dest reflect.Value, destKind reflect.Kind, ctx *_MirrorContext) error {
+	encoding := BytesRaw
+	if ctx.bestEffort {
+		encoding = ctx.BytesEncoding()
+	}
+	data, err := _DecodeBytes(source.String(), encoding)
+	if err != nil {
+		return ctx.NewError(source, dest, "Bytes", err)
+	}
+	if destKind == reflect.Array {
+		return _HandleArray(reflect.ValueOf(data), dest, reflect.Slice, destKind, ctx)
+	}
+	destType := dest.Type()
+	if destType.Elem() == reflect.TypeOf(byte(0)) {
+		dest.Set(reflect.ValueOf(data).Convert(destType))
+		return nil
+	}
+	value := reflect.MakeSlice(destType, len(data), len(data))
+	for i, element := range data {
+		value.Index(i).SetUint(uint64(element))
+	}
+	dest.Set(value)
+	return nil
+}
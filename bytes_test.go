@@ -0,0 +1,148 @@
+package mirror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBytesEncoding(t *testing.T) {
+	data := []byte{0xfb, 0xff}
+	testCases := []struct {
+		name     string
+		encoding BytesEncoding
+		expected string
+	}{
+		{"Raw", BytesRaw, "\xfb\xff"},
+		{"Base64", BytesBase64, "+/8="},
+		{"Base64URL", BytesBase64URL, "-_8="},
+		{"Hex", BytesHex, "fbff"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := ""
+			assert.NoError(t, SmartMirror(&data, &dest, WithBytesEncoding(testCase.encoding)))
+			assert.Equal(t, testCase.expected, dest)
+
+			result := []byte{}
+			assert.NoError(t, SmartMirror(&dest, &result, WithBytesEncoding(testCase.encoding)))
+			assert.Equal(t, data, result)
+		})
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		source := []byte("hello")
+		dest := ""
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, "hello", dest)
+		assert.True(t, errors.Is(Mirror(&source, &dest), ErrTypeMismatch))
+	})
+
+	t.Run("StrictStringToBytes", func(t *testing.T) {
+		type MyByte byte
+		source := "abc"
+		dest := []byte{}
+		assert.NoError(t, Mirror(source, &dest))
+		assert.Equal(t, []byte{97, 98, 99}, dest)
+
+		hex := "fbff"
+		assert.NoError(t, Mirror(&hex, &dest, WithBytesEncoding(BytesHex)))
+		assert.Equal(t, []byte("fbff"), dest)
+
+		named := []MyByte{}
+		assert.NoError(t, Mirror(&source, &named))
+		assert.Equal(t, []MyByte{97, 98, 99}, named)
+
+		array := [3]byte{}
+		assert.True(t, errors.Is(Mirror(&source, &array), ErrTypeMismatch))
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		source := "zz"
+		dest := []byte{}
+		assert.True(t, errors.Is(SmartMirror(&source, &dest, WithBytesEncoding(BytesHex)), ErrParse))
+	})
+}
+
+func TestBytesArray(t *testing.T) {
+	type MyByte byte
+	source := [4]byte{0xde, 0xad, 0xbe, 0xef}
+	dest := ""
+	assert.NoError(t, SmartMirror(&source, &dest, WithBytesEncoding(BytesHex)))
+	assert.Equal(t, "deadbeef", dest)
+
+	result := [4]byte{}
+	assert.NoError(t, SmartMirror(&dest, &result, WithBytesEncoding(BytesHex)))
+	assert.Equal(t, source, result)
+
+	short := [8]byte{}
+	assert.True(t, errors.Is(SmartMirror(&dest, &short, WithBytesEncoding(BytesHex)), ErrLengthMismatch))
+
+	named := []MyByte{}
+	assert.NoError(t, SmartMirror(&dest, &named, WithBytesEncoding(BytesHex)))
+	assert.Equal(t, []MyByte{0xde, 0xad, 0xbe, 0xef}, named)
+}
+
+func TestBytesTag(t *testing.T) {
+	type Document struct {
+		Name        string
+		Fingerprint []byte   `mirror:"fingerprint,encoding=hex"`
+		Signature   [2]byte  `mirror:"signature,encoding=base64url"`
+		Chunks      [][]byte `mirror:"chunks,encoding=base64"`
+	}
+	source := Document{
+		Name:        "Doru",
+		Fingerprint: []byte{0xca, 0xfe},
+		Signature:   [2]byte{0xfb, 0xff},
+		Chunks:      [][]byte{{0xfb, 0xff}},
+	}
+	dest := map[string]interface{}{}
+	assert.NoError(t, SmartMirror(&source, &dest))
+	assert.Equal(t, "cafe", dest["fingerprint"])
+	assert.Equal(t, "-_8=", dest["signature"])
+
+	hash := map[string]string{}
+	assert.NoError(t, SmartMirror(&source, &hash))
+	assert.Equal(t, map[string]string{"Name": "Doru", "fingerprint": "cafe", "signature": "-_8="}, hash)
+
+	raw := map[string]interface{}{
+		"Name":        "Doru",
+		"fingerprint": "cafe",
+		"signature":   "-_8=",
+		"chunks":      []interface{}{"+/8="},
+	}
+	result := Document{}
+	assert.NoError(t, SmartMirror(&raw, &result))
+	assert.Equal(t, source, result)
+}
+
+func TestBytesInterface(t *testing.T) {
+	type Document struct {
+		Hash      []byte  `mirror:"hash"`
+		Signature [2]byte `mirror:"signature,encoding=hex"`
+		Body      []byte  `mirror:"body,encoding=raw"`
+	}
+	source := Document{
+		Hash:      []byte{0xfb, 0xff},
+		Signature: [2]byte{0xab, 0xcd},
+		Body:      []byte("hello"),
+	}
+
+	t.Run("Encoded", func(t *testing.T) {
+		dest := map[string]interface{}{}
+		assert.NoError(t, SmartMirror(&source, &dest, WithBytesEncoding(BytesBase64)))
+		assert.Equal(t, map[string]interface{}{
+			"hash":      "+/8=",
+			"signature": "abcd",
+			"body":      []byte("hello"),
+		}, dest)
+	})
+
+	t.Run("Raw", func(t *testing.T) {
+		dest := map[string]interface{}{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, []byte{0xfb, 0xff}, dest["hash"])
+		assert.Equal(t, "abcd", dest["signature"])
+	})
+}
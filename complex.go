@@ -0,0 +1,78 @@
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func _HandleComplex(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if !ctx.bestEffort {
+		//Complex of different precision is still the same kind of number, but it must fit
+		if sourceKind != reflect.Complex64 && sourceKind != reflect.Complex128 {
+			return ctx.NewError(source, dest, "Complex", ErrTypeMismatch)
+		}
+		return _SetComplex(source, dest, source.Complex(), ctx)
+	}
+	switch sourceKind {
+	case reflect.Complex64, reflect.Complex128:
+		return _SetComplex(source, dest, source.Complex(), ctx)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return _SetComplex(source, dest, complex(float64(source.Int()), 0), ctx)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return _SetComplex(source, dest, complex(float64(source.Uint()), 0), ctx)
+	case reflect.Float32, reflect.Float64:
+		return _SetComplex(source, dest, complex(source.Float(), 0), ctx)
+	case reflect.String:
+		number, err := _ParseComplex(source.String())
+		if err != nil {
+			return ctx.NewError(source, dest, "Complex", err)
+		}
+		return _SetComplex(source, dest, number, ctx)
+	case reflect.Slice, reflect.Array:
+		if source.Len() != 2 {
+			return ctx.NewError(source, dest, "Complex", fmt.Errorf("%w, source has %d element while complex need real and imaginary part", ErrLengthMismatch, source.Len()))
+		}
+		var parts [2]float64
+		for i := range parts {
+			ctx.PushIndex(i)
+			err := _RecursiveMirror(source.Index(i), reflect.ValueOf(&parts[i]).Elem(), ctx)
+			ctx.Pop()
+			if err != nil {
+				return err
+			}
+		}
+		return _SetComplex(source, dest, complex(parts[0], parts[1]), ctx)
+	default:
+		return ctx.NewError(source, dest, "Complex", ErrTypeMismatch)
+	}
+}
+
+//Set number into complex dest, finite number that doesn't fit is an error unless wrapping is allowed
+func _SetComplex(source, dest reflect.Value, number complex128, ctx *_MirrorContext) error {
+	if dest.OverflowComplex(number) && !ctx.config.wrapNumbers {
+		return ctx.NewError(source, dest, "Complex", _NewOverflowError(number, dest))
+	}
+	dest.SetComplex(number)
+	return nil
+}
+
+//Parse complex number in form of "1+2i", "(1+2i)", real only "3" or imaginary only "2i"
+func _ParseComplex(raw string) (complex128, error) {
+	raw = strings.TrimSpace(raw)
+	if real, err := strconv.ParseFloat(raw, 64); err == nil {
+		return complex(real, 0), nil
+	}
+	if strings.HasSuffix(raw, "i") {
+		if imaginary, err := strconv.ParseFloat(raw[:len(raw)-1], 64); err == nil {
+			return complex(0, imaginary), nil
+		}
+	}
+	var number complex128
+	reader := strings.NewReader(raw)
+	if _, err := fmt.Fscan(reader, &number); err != nil || reader.Len() > 0 {
+		return 0, fmt.Errorf("%w, %q is not a complex number", ErrParse, raw)
+	}
+	return number, nil
+}
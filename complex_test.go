@@ -0,0 +1,91 @@
+package mirror
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplex(t *testing.T) {
+	t.Run("SameKind", func(t *testing.T) {
+		source := complex128(1 + 2i)
+		dest := complex128(0)
+		assert.NoError(t, Mirror(&source, &dest))
+		assert.Equal(t, source, dest)
+	})
+
+	t.Run("DifferentPrecision", func(t *testing.T) {
+		source := struct{ Value complex128 }{1 + 2i}
+		dest := struct{ Value complex64 }{}
+		assert.NoError(t, Mirror(&source, &dest))
+		assert.Equal(t, complex64(1+2i), dest.Value)
+		dest.Value = 0
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, complex64(1+2i), dest.Value)
+		back := struct{ Value complex128 }{}
+		assert.NoError(t, Mirror(&dest, &back))
+		assert.Equal(t, source, back)
+	})
+
+	t.Run("NotComplex", func(t *testing.T) {
+		source := 1.5
+		dest := complex128(0)
+		assert.True(t, errors.Is(Mirror(&source, &dest), ErrTypeMismatch))
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		source := complex(math.MaxFloat64, 0)
+		dest := complex64(0)
+		assert.True(t, errors.Is(Mirror(&source, &dest), ErrOverflow))
+		assert.True(t, errors.Is(SmartMirror(&source, &dest), ErrOverflow))
+	})
+}
+
+func TestComplexSmart(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   interface{}
+		expected complex128
+		err      error
+	}{
+		{"String", "1+2i", 1 + 2i, nil},
+		{"Parenthesis", " (1.5-2e1i) ", 1.5 - 20i, nil},
+		{"RealString", "3", 3, nil},
+		{"ImaginaryString", "-2i", -2i, nil},
+		{"InvalidString", "1+2j", 0, ErrParse},
+		{"TrailingString", "1+2i x", 0, ErrParse},
+		{"Int", int16(-3), -3, nil},
+		{"Uint", uint8(3), 3, nil},
+		{"Float", float32(1.5), 1.5, nil},
+		{"Slice", []float64{1, 2}, 1 + 2i, nil},
+		{"InterfaceSlice", []interface{}{"1", 2}, 1 + 2i, nil},
+		{"Array", [2]int{-1, 1}, -1 + 1i, nil},
+		{"ShortSlice", []float64{1}, 0, ErrLengthMismatch},
+		{"InvalidSlice", []interface{}{1, "A"}, 0, ErrParse},
+		{"Bool", true, 0, ErrTypeMismatch},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := complex128(0)
+			err := SmartMirror(&testCase.source, &dest)
+			if testCase.err != nil {
+				assert.True(t, errors.Is(err, testCase.err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, dest)
+		})
+	}
+
+	t.Run("ToString", func(t *testing.T) {
+		source := complex64(1 + 2i)
+		dest := ""
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, "(1+2i)", dest)
+		result := complex64(0)
+		assert.NoError(t, SmartMirror(&dest, &result))
+		assert.Equal(t, source, result)
+	})
+}
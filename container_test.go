@@ -0,0 +1,100 @@
+package mirror
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ContainerStruct struct {
+	Labels map[string]string
+	Tags   []string
+	Child  *PrimitiveStruct
+	Nested struct {
+		Labels map[string]int
+	}
+}
+
+func TestAllocateNilContainer(t *testing.T) {
+	source := map[string]interface{}{
+		"Labels": map[string]interface{}{"env": "prod"},
+		"Tags":   []interface{}{"A"},
+		"Child":  map[string]interface{}{"Name": "Doru", "Age": 1},
+		"Nested": map[string]interface{}{
+			"Labels": map[string]interface{}{"replica": 3},
+		},
+	}
+	dest := ContainerStruct{}
+	assert.NoError(t, SmartMirror(&source, &dest))
+	assert.Equal(t, map[string]string{"env": "prod"}, dest.Labels)
+	assert.Equal(t, []string{"A"}, dest.Tags)
+	assert.Equal(t, &PrimitiveStruct{"Doru", 1}, dest.Child)
+	assert.Equal(t, map[string]int{"replica": 3}, dest.Nested.Labels)
+
+	t.Run("StructToNilMap", func(t *testing.T) {
+		source := PrimitiveStruct{"Doru", 1}
+		dest := struct {
+			Child map[string]interface{}
+		}{}
+		wrapper := struct {
+			Child PrimitiveStruct
+		}{source}
+		assert.NoError(t, Mirror(&wrapper, &dest))
+		assert.Equal(t, map[string]interface{}{"Name": "Doru", "Age": uint(1)}, dest.Child)
+	})
+}
+
+func TestReuseContainer(t *testing.T) {
+	source := map[string]interface{}{
+		"Labels": map[string]interface{}{"env": "prod"},
+		"Child":  map[string]interface{}{"Name": "Doru"},
+	}
+
+	t.Run("Reuse", func(t *testing.T) {
+		labels := map[string]string{"team": "core"}
+		child := &PrimitiveStruct{"Ren", 2}
+		dest := ContainerStruct{Labels: labels, Child: child}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, map[string]string{"team": "core", "env": "prod"}, dest.Labels)
+		assert.False(t, child == dest.Child)
+		assert.Equal(t, PrimitiveStruct{"Doru", 0}, *dest.Child)
+		assert.Equal(t, PrimitiveStruct{"Ren", 2}, *child)
+	})
+
+	t.Run("ReusePointer", func(t *testing.T) {
+		child := &PrimitiveStruct{"Ren", 2}
+		dest := ContainerStruct{Child: child}
+		assert.NoError(t, SmartMirror(&source, &dest, WithReusePointers(true)))
+		assert.True(t, child == dest.Child)
+		assert.Equal(t, PrimitiveStruct{"Doru", 2}, *dest.Child)
+	})
+
+	t.Run("SharedPointer", func(t *testing.T) {
+		type Item struct {
+			N int
+		}
+		type Holder struct {
+			A *Item
+			B *Item
+		}
+		shared := &Item{1}
+		dest := Holder{shared, shared}
+		source := map[string]interface{}{"A": map[string]interface{}{"N": 99}}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, 99, dest.A.N)
+		assert.Equal(t, 1, dest.B.N)
+		assert.Equal(t, 1, shared.N)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		labels := map[string]string{"team": "core"}
+		child := &PrimitiveStruct{"Ren", 2}
+		dest := ContainerStruct{Labels: labels, Child: child}
+		assert.NoError(t, SmartMirror(&source, &dest, WithReuseContainers(false)))
+		assert.Equal(t, map[string]string{"env": "prod"}, dest.Labels)
+		assert.Equal(t, map[string]string{"team": "core"}, labels)
+		assert.False(t, child == dest.Child)
+		assert.Equal(t, PrimitiveStruct{"Doru", 0}, *dest.Child)
+		assert.Equal(t, PrimitiveStruct{"Ren", 2}, *child)
+	})
+}
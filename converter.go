@@ -0,0 +1,157 @@
+package mirror
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+//ConverterFunc convert source into destination
+//Destination is always set-able and has the type the converter is registered with
+type ConverterFunc func(source, dest reflect.Value) error
+
+type _TypePair struct {
+	source reflect.Type
+	dest   reflect.Type
+}
+
+type _ConverterEntry struct {
+	_TypePair
+	converter ConverterFunc
+}
+
+//ConverterRegistry hold custom converter keyed by source and destination type
+//It's consulted before the builtin conversion for both Mirror and SmartMirror
+//It's safe for concurrent use
+type ConverterRegistry struct {
+	mutex    sync.RWMutex
+	entries  []_ConverterEntry
+	size     int32 //Number of entries, read without lock on every call
+	resolved map[_TypePair]ConverterFunc
+}
+
+//Create an empty converter registry
+func NewConverterRegistry() *ConverterRegistry {
+	return &ConverterRegistry{
+		resolved: make(map[_TypePair]ConverterFunc),
+	}
+}
+
+//Register converter from source type to destination type
+//Registering the same pair twice will replace the previous converter
+func (r *ConverterRegistry) Register(sourceType, destType reflect.Type, converter ConverterFunc) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	pair := _TypePair{sourceType, destType}
+	entry := _ConverterEntry{pair, converter}
+	replaced := false
+	for i, val := range r.entries {
+		if val._TypePair == pair {
+			r.entries[i] = entry
+			replaced = true
+		}
+	}
+	if !replaced {
+		r.entries = append(r.entries, entry)
+		atomic.StoreInt32(&r.size, int32(len(r.entries)))
+	}
+	r.resolved = make(map[_TypePair]ConverterFunc)
+}
+
+//Find converter for source and destination type
+//Exact pair is preferred, otherwise the first registered converter whose source type
+//is assignable from source type (ex : an interface it implement) and whose destination type
+//is assignable to destination type will be used
+func (r *ConverterRegistry) Lookup(sourceType, destType reflect.Type) (ConverterFunc, bool) {
+	pair := _TypePair{sourceType, destType}
+	r.mutex.RLock()
+	if len(r.entries) == 0 {
+		r.mutex.RUnlock()
+		return nil, false
+	}
+	converter, ok := r.resolved[pair]
+	r.mutex.RUnlock()
+	if ok {
+		return converter, converter != nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	converter = r.resolve(pair)
+	r.resolved[pair] = converter
+	return converter, converter != nil
+}
+
+//Report whether any converter is registered
+func (r *ConverterRegistry) hasConverter() bool {
+	return atomic.LoadInt32(&r.size) > 0
+}
+
+func (r *ConverterRegistry) resolve(pair _TypePair) ConverterFunc {
+	for _, entry := range r.entries {
+		if entry._TypePair == pair {
+			return entry.converter
+		}
+	}
+	for _, entry := range r.entries {
+		if !pair.source.AssignableTo(entry.source) || !entry.dest.AssignableTo(pair.dest) {
+			continue
+		}
+		if entry.dest == pair.dest {
+			return entry.converter
+		}
+		converter := entry.converter
+		entryDest := entry.dest
+		return func(source, dest reflect.Value) error {
+			value := reflect.New(entryDest).Elem()
+			if err := converter(source, value); err != nil {
+				return err
+			}
+			dest.Set(value)
+			return nil
+		}
+	}
+	return nil
+}
+
+//Create a copy of registry containing the same converter
+func (r *ConverterRegistry) Clone() *ConverterRegistry {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	registry := NewConverterRegistry()
+	registry.entries = append(registry.entries, r.entries...)
+	registry.size = int32(len(registry.entries))
+	return registry
+}
+
+//Find converter for source and destination value
+//Non nil interface source is also matched by it's dynamic type
+func (ctx *_MirrorContext) FindConverter(source, dest reflect.Value) (ConverterFunc, reflect.Value, bool) {
+	if !ctx.converters {
+		return nil, source, false
+	}
+	registry := ctx.config.registry
+	if converter, ok := registry.Lookup(source.Type(), dest.Type()); ok {
+		return converter, source, true
+	}
+	if source.Kind() == reflect.Interface && !source.IsNil() {
+		source = source.Elem()
+		if converter, ok := registry.Lookup(source.Type(), dest.Type()); ok {
+			return converter, source, true
+		}
+	}
+	return nil, source, false
+}
+
+//Run converter and wrap it's error
+func (ctx *_MirrorContext) Convert(converter ConverterFunc, source, dest reflect.Value) error {
+	if err := converter(source, dest); err != nil {
+		mirrorErr := &MirrorError{}
+		if errors.As(err, &mirrorErr) {
+			return err
+		}
+		return ctx.NewError(source, dest, "Converter", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package mirror
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type Money struct {
+	Cents int64
+}
+
+type Label string
+
+func (l Label) String() string {
+	return strings.ToUpper(string(l))
+}
+
+func TestConverterRegistry(t *testing.T) {
+	registry := NewConverterRegistry()
+	registry.Register(reflect.TypeOf(""), reflect.TypeOf(Money{}), func(source, dest reflect.Value) error {
+		var whole, cents int64
+		if _, err := fmt.Sscanf(source.String(), "%d.%d", &whole, &cents); err != nil {
+			return err
+		}
+		dest.Set(reflect.ValueOf(Money{whole*100 + cents}))
+		return nil
+	})
+	registry.Register(reflect.TypeOf(int64(0)), reflect.TypeOf(time.Time{}), func(source, dest reflect.Value) error {
+		dest.Set(reflect.ValueOf(time.Unix(source.Int(), 0).UTC()))
+		return nil
+	})
+	stringerType := reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	registry.Register(stringerType, reflect.TypeOf(""), func(source, dest reflect.Value) error {
+		dest.SetString(source.Interface().(fmt.Stringer).String())
+		return nil
+	})
+
+	type Invoice struct {
+		Price     Money
+		CreatedAt time.Time
+		Label     string
+		Note      interface{}
+	}
+
+	t.Run("Exact", func(t *testing.T) {
+		source := map[string]interface{}{
+			"Price":     "12.50",
+			"CreatedAt": int64(86400),
+			"Label":     "draft",
+		}
+		dest := Invoice{}
+		assert.NoError(t, SmartMirror(&source, &dest, WithConverterRegistry(registry)))
+		assert.Equal(t, Money{1250}, dest.Price)
+		assert.Equal(t, time.Unix(86400, 0).UTC(), dest.CreatedAt)
+		assert.Equal(t, "draft", dest.Label)
+	})
+
+	t.Run("Interface", func(t *testing.T) {
+		source := map[string]interface{}{
+			"Label": Label("draft"),
+		}
+		dest := map[string]string{}
+		assert.NoError(t, Mirror(&source, &dest, WithConverterRegistry(registry)))
+		assert.Equal(t, "DRAFT", dest["Label"])
+	})
+
+	t.Run("AssignableDestination", func(t *testing.T) {
+		source := map[string]interface{}{
+			"Note": "1.05",
+		}
+		dest := Invoice{}
+		registry := NewConverterRegistry()
+		registry.Register(reflect.TypeOf(""), reflect.TypeOf(Money{}), func(source, dest reflect.Value) error {
+			dest.Set(reflect.ValueOf(Money{105}))
+			return nil
+		})
+		assert.NoError(t, SmartMirror(&source, &dest, WithConverterRegistry(registry)))
+		assert.Equal(t, Money{105}, dest.Note)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		source := map[string]interface{}{
+			"Price": "free",
+		}
+		dest := Invoice{}
+		err := Mirror(&source, &dest, WithConverterRegistry(registry))
+		mirrorErr := &MirrorError{}
+		if assert.True(t, errors.As(err, &mirrorErr)) {
+			assert.Equal(t, "Price", mirrorErr.Path)
+			assert.Equal(t, "Converter", mirrorErr.Handler)
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package mirror
+
+import (
+	"reflect"
+	"sync"
+)
+
+var _ReferenceTypeCache sync.Map
+
+//Report whether value of type may share memory with it's copy
+//Only exported struct field is considered since unexported field can't be mirrored
+func _HasReference(valueType reflect.Type) bool {
+	if cached, ok := _ReferenceTypeCache.Load(valueType); ok {
+		return cached.(bool)
+	}
+	result := false
+	switch valueType.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		result = true
+	case reflect.Array:
+		result = _HasReference(valueType.Elem())
+	case reflect.Struct:
+		for i := 0; i < valueType.NumField(); i++ {
+			field := valueType.Field(i)
+			if field.PkgPath == "" && _HasReference(field.Type) {
+				result = true
+				break
+			}
+		}
+	}
+	_ReferenceTypeCache.Store(valueType, result)
+	return result
+}
+
+//Handle deep copy between value of the same type
+//Every child is mirrored again so converter and other option still apply
+//Pointer, map and slice that is shared in source will also be shared in destination
+func _DeepCopy(source, dest reflect.Value, ctx *_MirrorContext) error {
+	var key _VisitKey
+	trackable := false
+	switch source.Kind() {
+	case reflect.Ptr:
+		key, trackable = _NewVisitKey(source.Elem(), source.Type())
+	case reflect.Map, reflect.Slice:
+		key, trackable = _NewVisitKey(source, source.Type())
+	}
+	if trackable {
+		if visited, ok := ctx.Visited(key); ok {
+			dest.Set(visited)
+			return nil
+		}
+	}
+
+	switch source.Kind() {
+	case reflect.Ptr:
+		value := reflect.New(source.Type().Elem())
+		if trackable {
+			ctx.Visit(key, value)
+		}
+		if err := _RecursiveMirror(source.Elem(), value.Elem(), ctx); err != nil {
+			return err
+		}
+		dest.Set(value)
+	case reflect.Interface:
+		if source.IsNil() {
+			dest.Set(source)
+			return nil
+		}
+		elem := source.Elem()
+		value := reflect.New(elem.Type()).Elem()
+		if err := _RecursiveMirror(elem, value, ctx); err != nil {
+			return err
+		}
+		dest.Set(value)
+	case reflect.Slice:
+		length := source.Len()
+		value := reflect.MakeSlice(source.Type(), length, length)
+		if trackable {
+			ctx.Visit(key, value)
+		}
+		if !_HasReference(source.Type().Elem()) {
+			reflect.Copy(value, source)
+			dest.Set(value)
+			return nil
+		}
+		for i := 0; i < length; i++ {
+			ctx.PushIndex(i)
+			err := _RecursiveMirror(source.Index(i), value.Index(i), ctx)
+			ctx.Pop()
+			if err != nil {
+				return err
+			}
+		}
+		dest.Set(value)
+	case reflect.Array:
+		dest.Set(reflect.Zero(source.Type()))
+		for i := 0; i < source.Len(); i++ {
+			ctx.PushIndex(i)
+			err := _RecursiveMirror(source.Index(i), dest.Index(i), ctx)
+			ctx.Pop()
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		value := reflect.MakeMapWithSize(source.Type(), source.Len())
+		if trackable {
+			ctx.Visit(key, value)
+		}
+		keyType := source.Type().Key()
+		elemType := source.Type().Elem()
+		mapEntry := source.MapRange()
+		for mapEntry.Next() {
+			key := reflect.New(keyType).Elem()
+			elem := reflect.New(elemType).Elem()
+			ctx.PushKey(mapEntry.Key())
+			err := _RecursiveMirror(mapEntry.Key(), key, ctx)
+			if err == nil {
+				err = _RecursiveMirror(mapEntry.Value(), elem, ctx)
+			}
+			ctx.Pop()
+			if err != nil {
+				return err
+			}
+			value.SetMapIndex(key, elem)
+		}
+		dest.Set(value)
+	case reflect.Struct:
+		//Copy unexported field as is, then replace exported field with it's copy
+		//Field is cleared first so it's not mirrored into the source container
+		dest.Set(source)
+		sourceType := source.Type()
+		for i := 0; i < source.NumField(); i++ {
+			field := sourceType.Field(i)
+			if field.PkgPath != "" || !_HasReference(field.Type) {
+				continue
+			}
+			dest.Field(i).Set(reflect.Zero(field.Type))
+			ctx.PushField(field.Name, nil)
+			err := _RecursiveMirror(source.Field(i), dest.Field(i), ctx)
+			ctx.Pop()
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		dest.Set(source)
+	}
+	return nil
+}
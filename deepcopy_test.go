@@ -0,0 +1,106 @@
+package mirror
+
+import (
+	"crypto/sha512"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type DeepStruct struct {
+	Name     string
+	Tags     []string
+	Labels   map[string]string
+	Child    *PrimitiveStruct
+	Children []*PrimitiveStruct
+	Any      interface{}
+	Matrix   [2][]int
+	hidden   []int
+}
+
+func _GetDeepSource() DeepStruct {
+	return DeepStruct{
+		Name:     "Rendoru",
+		Tags:     []string{"A", "B"},
+		Labels:   map[string]string{"env": "prod"},
+		Child:    &PrimitiveStruct{"Doru", 1},
+		Children: []*PrimitiveStruct{{"Ren", 2}},
+		Any:      []int{1, 2},
+		Matrix:   [2][]int{{1}, {2}},
+		hidden:   []int{1},
+	}
+}
+
+func TestDeepMirror(t *testing.T) {
+	source := _GetDeepSource()
+	dest := DeepStruct{}
+	assert.NoError(t, DeepMirror(&source, &dest))
+	assert.Equal(t, _GetDeepSource(), dest)
+
+	source.Tags[0] = "X"
+	source.Labels["env"] = "dev"
+	source.Child.Name = "X"
+	source.Children[0].Name = "X"
+	source.Any.([]int)[0] = 9
+	source.Matrix[0][0] = 9
+	assert.Equal(t, _GetDeepSource(), dest)
+}
+
+func TestDeepMirrorToMap(t *testing.T) {
+	fingerprint := sha512.Sum512([]byte("A Fingerprint"))
+	source := ParentOrganism{
+		Name:        "Rendoru",
+		Fingerprint: append([]byte(nil), fingerprint[:]...),
+	}
+	dest := map[string]interface{}{}
+	assert.NoError(t, DeepMirror(&source, &dest))
+	source.Fingerprint[0]++
+	assert.Equal(t, fingerprint[:], dest["Fingerprint"])
+}
+
+func TestShallowMirror(t *testing.T) {
+	source := _GetDeepSource()
+	dest := DeepStruct{}
+	assert.NoError(t, Mirror(&source, &dest))
+	source.Tags[0] = "X"
+	assert.Equal(t, "X", dest.Tags[0])
+}
+
+func TestClone(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		source := _GetDeepSource()
+		result, err := Clone(source)
+		assert.NoError(t, err)
+		clone := result.(DeepStruct)
+		source.Tags[0] = "X"
+		assert.Equal(t, _GetDeepSource(), clone)
+	})
+
+	t.Run("Pointer", func(t *testing.T) {
+		source := &PrimitiveStruct{"Rendoru", 22}
+		result, err := Clone(source)
+		assert.NoError(t, err)
+		clone := result.(*PrimitiveStruct)
+		assert.Equal(t, source, clone)
+		assert.False(t, source == clone)
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		source := map[string][]int{"A": {1}}
+		result, err := Clone(source)
+		assert.NoError(t, err)
+		source["A"][0] = 2
+		assert.Equal(t, map[string][]int{"A": {1}}, result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		result, err := Clone(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+
+		var source *PrimitiveStruct
+		result, err = Clone(source)
+		assert.NoError(t, err)
+		assert.Nil(t, result.(*PrimitiveStruct))
+	})
+}
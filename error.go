@@ -0,0 +1,197 @@
+package mirror
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	//Source value can't be mirrored into destination type
+	ErrTypeMismatch = errors.New("Destination field type didn't match Source field type")
+	//Source value has the right type but it's content can't be parsed
+	ErrParse = errors.New("Failed to parse Source value")
+	//Destination can't be set, usually because it's not passed as pointer
+	ErrNotSettable = errors.New("Destination is not set-able, are you passing non pointer value?")
+	//More than one map key match a struct field
+	ErrAmbiguousKey = errors.New("More than one key match destination field")
+	//Reflect panicked while mirroring, usually caused by unsupported type combination
+	ErrPanic = errors.New("Recovered from panic")
+	//Source exceed configured depth, element or node limit, mirroring is always aborted
+	ErrLimitExceeded = errors.New("Source exceed mirroring limit")
+	//Number doesn't fit in destination type or change sign, see [WithNumberWrapping]
+	ErrOverflow = errors.New("Source number overflow Destination type")
+	//Float has fractional part while rounding policy reject it, see [RoundReject]
+	ErrPrecisionLoss = errors.New("Source number lose precision in Destination type")
+	//Source length differ from destination array length, see [ArrayPolicy]
+	ErrLengthMismatch = errors.New("Source length didn't match Destination array length")
+)
+
+//MirrorError describe a failure when mirroring a single value
+//Use errors.Is with the sentinel errors to check the reason of failure
+type MirrorError struct {
+	//Path to the failed value from the root, ex : Child.Tags[3].Name
+	Path string
+	//Type of the source value, nil if source is missing
+	SourceType reflect.Type
+	//Type of the destination value
+	DestinationType reflect.Type
+	//Name of handler that failed, ex : Int, MapToStruct
+	Handler string
+	//Cause of failure, wrap one of the sentinel errors
+	Err error
+}
+
+func (e *MirrorError) Error() string {
+	builder := strings.Builder{}
+	builder.WriteString("Failed to mirror ")
+	if e.Path != "" {
+		builder.WriteString(e.Path)
+		builder.WriteString(" ")
+	}
+	fmt.Fprintf(&builder, "from %v to %v using %s handler : %s", e.SourceType, e.DestinationType, e.Handler, e.Err)
+	return builder.String()
+}
+
+func (e *MirrorError) Unwrap() error {
+	return e.Err
+}
+
+//MirrorErrors hold every failure found when errors are collected, see [WithCollectErrors]
+type MirrorErrors []*MirrorError
+
+func (e MirrorErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("Found %d errors when mirroring : %s", len(e), strings.Join(messages, "; "))
+}
+
+//Report whether any of the collected error match target
+func (e MirrorErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+//Create parse error cause while keeping the original error message
+func _NewParseError(err error) error {
+	return fmt.Errorf("%w, %s", ErrParse, err.Error())
+}
+
+//Segment of path from the root value
+//Only one of field, index or key is used
+type _PathSegment struct {
+	field string
+	index int
+	key   reflect.Value
+	//Tag of the struct field, only set for field segment
+	tag *_FieldTag
+}
+
+func (ctx *_MirrorContext) PushField(name string, tag *_FieldTag) {
+	ctx.path = append(ctx.path, _PathSegment{field: name, tag: tag})
+}
+
+//Get tag of the struct field being mirrored
+//Return nil if the current value is not a struct field or the tag has no option
+//Element of slice or map inside the field won't see the tag
+func (ctx *_MirrorContext) FieldTag() *_FieldTag {
+	if len(ctx.path) == 0 {
+		return nil
+	}
+	return ctx.path[len(ctx.path)-1].tag
+}
+
+//Get tag of the nearest struct field
+//Unlike [_MirrorContext.FieldTag], element of slice or map inside the field will also see the tag
+func (ctx *_MirrorContext) InheritedTag() *_FieldTag {
+	for i := len(ctx.path) - 1; i >= 0; i-- {
+		if ctx.path[i].field != "" {
+			return ctx.path[i].tag
+		}
+	}
+	return nil
+}
+
+func (ctx *_MirrorContext) PushIndex(index int) {
+	ctx.path = append(ctx.path, _PathSegment{index: index})
+}
+
+func (ctx *_MirrorContext) PushKey(key reflect.Value) {
+	ctx.path = append(ctx.path, _PathSegment{key: key})
+}
+
+func (ctx *_MirrorContext) Pop() {
+	ctx.path = ctx.path[:len(ctx.path)-1]
+}
+
+//Get current path as string, ex : Child.Tags[3].Name
+func (ctx *_MirrorContext) FormatPath() string {
+	builder := strings.Builder{}
+	for _, segment := range ctx.path {
+		switch {
+		case segment.field != "":
+			if builder.Len() > 0 {
+				builder.WriteByte('.')
+			}
+			builder.WriteString(segment.field)
+		case segment.key.IsValid():
+			fmt.Fprintf(&builder, "[%v]", segment.key.Interface())
+		default:
+			builder.WriteByte('[')
+			builder.WriteString(strconv.Itoa(segment.index))
+			builder.WriteByte(']')
+		}
+	}
+	return builder.String()
+}
+
+//Create error for the current path
+func (ctx *_MirrorContext) NewError(source, dest reflect.Value, handler string, cause error) error {
+	err := &MirrorError{
+		Path:    ctx.FormatPath(),
+		Handler: handler,
+		Err:     cause,
+	}
+	if source.IsValid() {
+		err.SourceType = source.Type()
+	}
+	if dest.IsValid() {
+		err.DestinationType = dest.Type()
+	}
+	return err
+}
+
+//Collect error if error collection is enabled
+//Return false if the error should be handled by the caller instead
+func (ctx *_MirrorContext) Collect(err error) bool {
+	if !ctx.config.collectErrors || errors.Is(err, ErrLimitExceeded) {
+		return false
+	}
+	mirrorErr := &MirrorError{}
+	if !errors.As(err, &mirrorErr) {
+		mirrorErr = &MirrorError{
+			Path:    ctx.FormatPath(),
+			Handler: "Mirror",
+			Err:     err,
+		}
+	}
+	ctx.errs = append(ctx.errs, mirrorErr)
+	return true
+}
+
+//Report whether a failed child value can be skipped instead of aborting
+//Overflow and precision loss is never skipped so corrupted number doesn't go unnoticed
+func (ctx *_MirrorContext) Skip(err error) bool {
+	if ctx.Collect(err) {
+		return true
+	}
+	return ctx.bestEffort && !errors.Is(err, ErrLimitExceeded) && !errors.Is(err, ErrOverflow) && !errors.Is(err, ErrPrecisionLoss)
+}
@@ -0,0 +1,186 @@
+package mirror
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMirrorErrorPath(t *testing.T) {
+	type Tag struct {
+		Name string
+	}
+	type Child struct {
+		Tags []Tag
+	}
+	type Parent struct {
+		Child Child
+	}
+
+	source := map[string]interface{}{
+		"Child": map[string]interface{}{
+			"Tags": []interface{}{
+				map[string]interface{}{"Name": "A"},
+				map[string]interface{}{"Name": 2},
+			},
+		},
+	}
+	dest := Parent{}
+	err := Mirror(&source, &dest)
+
+	mirrorErr := &MirrorError{}
+	if !assert.True(t, errors.As(err, &mirrorErr)) {
+		return
+	}
+	assert.Equal(t, "Child.Tags[1].Name", mirrorErr.Path)
+	assert.Equal(t, reflect.TypeOf(0), mirrorErr.SourceType)
+	assert.Equal(t, reflect.TypeOf(""), mirrorErr.DestinationType)
+	assert.Equal(t, "String", mirrorErr.Handler)
+	assert.True(t, errors.Is(err, ErrTypeMismatch))
+}
+
+func TestMirrorErrorSentinel(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		source := map[string]string{"Name": "Rendoru", "Age": "twenty"}
+		destStruct := PrimitiveStruct{}
+		err := SmartMirror(&source, &destStruct)
+		assert.True(t, errors.Is(err, ErrParse))
+		assert.Contains(t, err.Error(), "Age")
+	})
+
+	t.Run("NotSettable", func(t *testing.T) {
+		source := 1
+		dest := 0
+		err := Mirror(&source, dest)
+		assert.True(t, errors.Is(err, ErrNotSettable))
+	})
+
+	t.Run("MapKey", func(t *testing.T) {
+		source := map[string]interface{}{"Age": "22"}
+		dest := map[string]int{}
+		err := Mirror(&source, &dest)
+		mirrorErr := &MirrorError{}
+		if assert.True(t, errors.As(err, &mirrorErr)) {
+			assert.Equal(t, "[Age]", mirrorErr.Path)
+			assert.Equal(t, "Int", mirrorErr.Handler)
+		}
+	})
+}
+
+func TestCollectErrors(t *testing.T) {
+	type Person struct {
+		Name   string
+		Age    int
+		Weight float64
+		Tags   []int
+	}
+
+	source := map[string]interface{}{
+		"Name":   1,
+		"Age":    "twenty",
+		"Weight": 60.5,
+		"Tags":   []interface{}{1, "two", 3},
+	}
+
+	t.Run("Mirror", func(t *testing.T) {
+		dest := Person{}
+		err := Mirror(&source, &dest, WithCollectErrors())
+		mirrorErrs := MirrorErrors{}
+		if !assert.True(t, errors.As(err, &mirrorErrs)) {
+			return
+		}
+		paths := make([]string, 0, len(mirrorErrs))
+		for _, val := range mirrorErrs {
+			paths = append(paths, val.Path)
+		}
+		assert.Equal(t, []string{"Age", "Name", "Tags[1]"}, paths)
+		assert.True(t, errors.Is(err, ErrTypeMismatch))
+		assert.Equal(t, 60.5, dest.Weight)
+		assert.Equal(t, []int{1, 3}, dest.Tags)
+	})
+
+	t.Run("SmartMirror", func(t *testing.T) {
+		dest := Person{}
+		err := SmartMirror(&source, &dest, WithCollectErrors())
+		mirrorErrs := MirrorErrors{}
+		if !assert.True(t, errors.As(err, &mirrorErrs)) {
+			return
+		}
+		assert.Len(t, mirrorErrs, 2)
+		assert.Equal(t, "Age", mirrorErrs[0].Path)
+		assert.Equal(t, "Tags[1]", mirrorErrs[1].Path)
+		assert.True(t, errors.Is(err, ErrParse))
+		assert.Equal(t, "1", dest.Name)
+		assert.Equal(t, []int{1, 3}, dest.Tags)
+	})
+
+	t.Run("NoError", func(t *testing.T) {
+		source := map[string]interface{}{"Name": "Rendoru"}
+		dest := Person{}
+		assert.NoError(t, SmartMirror(&source, &dest, WithCollectErrors()))
+	})
+}
+
+func TestRecoverPanic(t *testing.T) {
+	t.Run("Converter", func(t *testing.T) {
+		instance := New(WithConverter(reflect.TypeOf(""), reflect.TypeOf(0), func(source, dest reflect.Value) error {
+			dest.SetString(source.String())
+			return nil
+		}))
+		source := map[string]interface{}{"Name": "Rendoru", "Age": "22"}
+		dest := struct {
+			Name string
+			Age  int
+		}{}
+		err := instance.Mirror(&source, &dest)
+		mirrorErr := &MirrorError{}
+		if assert.True(t, errors.As(err, &mirrorErr)) {
+			assert.Equal(t, "Age", mirrorErr.Path)
+			assert.True(t, errors.Is(err, ErrPanic))
+		}
+		assert.Equal(t, "Rendoru", dest.Name)
+	})
+
+	t.Run("InterfaceNotImplemented", func(t *testing.T) {
+		type WithStringer struct {
+			Value interface{ String() string }
+		}
+		source := map[string]interface{}{"Value": 1}
+		dest := WithStringer{}
+		err := SmartMirror(&source, &dest)
+		mirrorErr := &MirrorError{}
+		if assert.True(t, errors.As(err, &mirrorErr)) {
+			assert.Equal(t, "Value", mirrorErr.Path)
+			assert.Equal(t, "Interface", mirrorErr.Handler)
+			assert.True(t, errors.Is(err, ErrTypeMismatch))
+		}
+	})
+
+	t.Run("ArrayDestination", func(t *testing.T) {
+		type WithArray struct {
+			Values [2]string
+		}
+		source := map[string]interface{}{"Values": []interface{}{"A", "B", "C"}}
+		dest := WithArray{}
+		err := Mirror(&source, &dest)
+		mirrorErr := &MirrorError{}
+		if assert.True(t, errors.As(err, &mirrorErr)) {
+			assert.Equal(t, "Values", mirrorErr.Path)
+			assert.Equal(t, "Array", mirrorErr.Handler)
+			assert.True(t, errors.Is(err, ErrLengthMismatch))
+		}
+	})
+
+	t.Run("CollectPanic", func(t *testing.T) {
+		source := []interface{}{1, "A", 3}
+		dest := []interface{ String() string }{}
+		err := Mirror(&source, &dest, WithCollectErrors())
+		mirrorErrs := MirrorErrors{}
+		if assert.True(t, errors.As(err, &mirrorErrs)) {
+			assert.Len(t, mirrorErrs, 3)
+			assert.Equal(t, "[0]", mirrorErrs[0].Path)
+		}
+	})
+}
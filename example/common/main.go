@@ -83,7 +83,7 @@ func main() {
 	//Since we are dealing with different data type, using Mirror will cause error
 	if err := mirror.Mirror(&rawMap, &parentOrganism); err != nil {
 		fmt.Println(err.Error()) //lets temporary change it to println
-		//OUTPUT : Destination field type didn't match Source field type
+		//OUTPUT : Failed to mirror Age from string to uint using Uint handler : Destination field type didn't match Source field type
 	}
 	//Now let's use SmartMirror, It behaves like mirror but perform transformation as needed
 	if err := mirror.SmartMirror(&rawMap, &parentOrganism); err != nil {
@@ -0,0 +1,67 @@
+package mirror
+
+import "reflect"
+
+//Instance hold it's own configuration and converter registry
+//Use it when different part of a program need different mirroring behaviour
+//It's safe for concurrent use after construction
+type Instance struct {
+	config      *_Config
+	smartConfig *_Config
+	deepConfig  *_Config
+}
+
+//Default instance keep the slice behavior of previous version
+//Element is appended to destination slice while slice of the same type is assigned
+var _DefaultInstance = New(func(config *_Config) {
+	config.slicePolicy = 0
+})
+
+//Create new instance configured by options
+//Option given to each call will be applied on top of the instance configuration
+func New(options ...Option) *Instance {
+	config := _NewConfig(options...)
+	smartConfig := *config
+	smartConfig.bestEffort = true
+	deepConfig := *config
+	deepConfig.deepCopy = true
+	return &Instance{
+		config:      config,
+		smartConfig: &smartConfig,
+		deepConfig:  &deepConfig,
+	}
+}
+
+//Convert arbitrary interface to certain structure
+//Will NOT attemp to convert data type unless [WithBestEffort] is used, see [Instance.SmartMirror]
+func (m *Instance) Mirror(source, destination interface{}, options ...Option) error {
+	return _Mirror(source, destination, m.config.With(options))
+}
+
+//Convert arbitrary interface to certain structure
+//Will also attemp to convert data type to best match the destination
+func (m *Instance) SmartMirror(source, destination interface{}, options ...Option) error {
+	return _Mirror(source, destination, m.smartConfig.With(options))
+}
+
+//Convert arbitrary interface to certain structure while duplicating every slice, map and pointer
+//Will NOT attemp to convert data type unless [WithBestEffort] is used
+func (m *Instance) DeepMirror(source, destination interface{}, options ...Option) error {
+	return _Mirror(source, destination, m.deepConfig.With(options))
+}
+
+//Create a deep copy of value, pointer will return pointer to the copy
+func (m *Instance) Clone(value interface{}, options ...Option) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	source := reflect.ValueOf(value)
+	if source.Kind() == reflect.Ptr && source.IsNil() {
+		return value, nil
+	}
+	dest := reflect.New(source.Type())
+	if err := m.DeepMirror(value, dest.Interface(), options...); err != nil {
+		return nil, err
+	}
+	return dest.Elem().Interface(), nil
+}
@@ -0,0 +1,91 @@
+package mirror
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceIsolation(t *testing.T) {
+	type Person struct {
+		FirstName string
+		Age       int
+	}
+
+	double := New(
+		WithNameStrategy(SnakeCase),
+		WithConverter(reflect.TypeOf(0), reflect.TypeOf(0), func(source, dest reflect.Value) error {
+			dest.SetInt(source.Int() * 2)
+			return nil
+		}),
+	)
+	plain := New()
+
+	source := Person{FirstName: "Rendoru", Age: 22}
+
+	doubleDest := map[string]interface{}{}
+	assert.NoError(t, double.Mirror(&source, &doubleDest))
+	assert.Equal(t, map[string]interface{}{"first_name": "Rendoru", "age": 44}, doubleDest)
+
+	plainDest := map[string]interface{}{}
+	assert.NoError(t, plain.Mirror(&source, &plainDest))
+	assert.Equal(t, map[string]interface{}{"FirstName": "Rendoru", "Age": 22}, plainDest)
+
+	defaultDest := map[string]interface{}{}
+	assert.NoError(t, Mirror(&source, &defaultDest))
+	assert.Equal(t, plainDest, defaultDest)
+}
+
+func TestInstanceStrictness(t *testing.T) {
+	source := map[string]interface{}{"Name": "Rendoru", "Age": "22"}
+
+	dest := PrimitiveStruct{}
+	assert.Error(t, New().Mirror(&source, &dest))
+
+	dest = PrimitiveStruct{}
+	assert.NoError(t, New(WithBestEffort()).Mirror(&source, &dest))
+	assert.Equal(t, PrimitiveStruct{"Rendoru", 22}, dest)
+
+	dest = PrimitiveStruct{}
+	assert.NoError(t, New().SmartMirror(&source, &dest))
+	assert.Equal(t, PrimitiveStruct{"Rendoru", 22}, dest)
+}
+
+func TestInstanceCallConverterNotShared(t *testing.T) {
+	instance := New()
+	toUpper := WithConverter(reflect.TypeOf(""), reflect.TypeOf(""), func(source, dest reflect.Value) error {
+		dest.SetString(strings.ToUpper(source.String()))
+		return nil
+	})
+	source := map[string]interface{}{"Name": "Rendoru", "Age": uint(22)}
+
+	dest := PrimitiveStruct{}
+	assert.NoError(t, instance.Mirror(&source, &dest, toUpper))
+	assert.Equal(t, "RENDORU", dest.Name)
+
+	dest = PrimitiveStruct{}
+	assert.NoError(t, instance.Mirror(&source, &dest))
+	assert.Equal(t, "Rendoru", dest.Name)
+}
+
+func TestInstanceConcurrent(t *testing.T) {
+	instance := New(WithNameStrategy(CamelCase))
+	wg := sync.WaitGroup{}
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			source := map[string]interface{}{"name": "Rendoru", "age": i}
+			dest := struct {
+				Name string
+				Age  int
+			}{}
+			assert.NoError(t, instance.SmartMirror(&source, &dest))
+			assert.Equal(t, i, dest.Age)
+		}(i)
+	}
+	wg.Wait()
+}
@@ -2,7 +2,24 @@ package mirror
 
 import "reflect"
 
-func _HandleInterface(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
+func _HandleInterface(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if !source.Type().AssignableTo(dest.Type()) {
+		return ctx.NewError(source, dest, "Interface", ErrTypeMismatch)
+	}
+	//Encoded byte is kept as string so it's the same as mirroring into string field
+	if ctx.bestEffort && dest.NumMethod() == 0 && _IsBytes(source.Type()) {
+		if encoding := ctx.BytesEncoding(); encoding != BytesRaw {
+			dest.Set(reflect.ValueOf(_EncodeBytes(source, encoding)))
+			return nil
+		}
+	}
+	if ctx.config.deepCopy && _HasReference(source.Type()) {
+		value := reflect.New(source.Type()).Elem()
+		if err := _DeepCopy(source, value, ctx); err != nil {
+			return err
+		}
+		source = value
+	}
 	dest.Set(source)
 	return nil
 }
@@ -0,0 +1,27 @@
+package mirror
+
+import (
+	"fmt"
+	"reflect"
+)
+
+//Check configured limit before mirroring a value
+func (ctx *_MirrorContext) CheckLimit(source, dest reflect.Value) error {
+	config := ctx.config
+	ctx.nodes++
+	if config.maxNodes > 0 && ctx.nodes > config.maxNodes {
+		return ctx.NewError(source, dest, "Limit", fmt.Errorf("%w, visited more than %d node", ErrLimitExceeded, config.maxNodes))
+	}
+	if config.maxDepth > 0 && len(ctx.path) > config.maxDepth {
+		return ctx.NewError(source, dest, "Limit", fmt.Errorf("%w, nested deeper than %d", ErrLimitExceeded, config.maxDepth))
+	}
+	if config.maxElements > 0 {
+		switch source.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if source.Len() > config.maxElements {
+				return ctx.NewError(source, dest, "Limit", fmt.Errorf("%w, contain more than %d element", ErrLimitExceeded, config.maxElements))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package mirror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func _GetNestedMap(depth int) map[string]interface{} {
+	root := map[string]interface{}{}
+	current := root
+	for i := 0; i < depth; i++ {
+		child := map[string]interface{}{}
+		current["Parent"] = child
+		current = child
+	}
+	return root
+}
+
+func TestLimit(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Source   interface{}
+		Option   Option
+		HasError bool
+	}{
+		{"DepthWithin", _GetNestedMap(5), WithMaxDepth(10), false},
+		{"DepthExceeded", _GetNestedMap(20), WithMaxDepth(10), true},
+		{"ElementsWithin", []interface{}{1, 2, 3}, WithMaxElements(3), false},
+		{"ElementsExceeded", []interface{}{1, 2, 3, 4}, WithMaxElements(3), true},
+		{"MapElementsExceeded", map[string]interface{}{"Name": "A", "Parent": nil}, WithMaxElements(1), true},
+		{"NodesWithin", []interface{}{1, 2, 3}, WithMaxNodes(10), false},
+		{"NodesExceeded", _GetNestedMap(20), WithMaxNodes(10), true},
+	}
+
+	for _, val := range testData {
+		t.Run(val.Name, func(t *testing.T) {
+			dest := interface{}(nil)
+			switch val.Source.(type) {
+			case []interface{}:
+				dest = &[]int{}
+			default:
+				dest = &OtherTreeNode{}
+			}
+			err := SmartMirror(&val.Source, dest, val.Option, WithCollectErrors())
+			if !val.HasError {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, ErrLimitExceeded))
+			mirrorErr := &MirrorError{}
+			assert.True(t, errors.As(err, &mirrorErr))
+		})
+	}
+}
+
+func TestLimitNestedStruct(t *testing.T) {
+	source := _GetNestedMap(50)
+	dest := OtherTreeNode{}
+	err := SmartMirror(&source, &dest, WithMaxDepth(8))
+	mirrorErr := &MirrorError{}
+	if assert.True(t, errors.As(err, &mirrorErr)) {
+		assert.True(t, errors.Is(err, ErrLimitExceeded))
+		assert.Equal(t, "Limit", mirrorErr.Handler)
+	}
+}
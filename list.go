@@ -5,15 +5,50 @@ import (
 )
 
 //Handle conversion for list dest
-//Will add all element from source to dest
-func _HandleList(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
+//Will add all element from source to dest according to [SlicePolicy]
+func _HandleList(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	//String is a list of byte, so strict mirror accept it too
+	if sourceKind == reflect.String && _IsBytes(dest.Type()) {
+		return _HandleStringToBytes(source, dest, destKind, ctx)
+	}
+	if sourceKind != reflect.Slice && sourceKind != reflect.Array {
+		return ctx.NewError(source, dest, "List", ErrTypeMismatch)
+	}
 	length := source.Len()
 	destType := dest.Type()
 	destValue := destType.Elem()
+	//Reuse slice created for the same source so cycle terminate
+	//Destination that is reused keep it's own element so it never take visited slice
+	policy, key := ctx.SlicePolicy()
+	fresh := dest.IsNil() || !ctx.config.reuseContainers || policy == SliceReplace
+	visitKey, trackable := _VisitKey{}, false
+	if sourceKind == reflect.Slice {
+		visitKey, trackable = _NewVisitKey(source, destType)
+		if visited, ok := ctx.Visited(visitKey); fresh && trackable && ok {
+			dest.Set(visited)
+			return nil
+		}
+	}
+	if fresh {
+		dest.Set(reflect.MakeSlice(destType, 0, length))
+	} else if policy == SliceMerge {
+		return _MergeList(source, dest, key, ctx)
+	}
+	//Only empty slice is remembered, reused slice with element must not be handed to other field
+	if trackable && dest.Len() == 0 {
+		//Element are appended in place, so the visited slice see them once mirrored
+		if dest.Cap() < length {
+			dest.Set(reflect.MakeSlice(destType, 0, length))
+		}
+		ctx.Visit(visitKey, dest.Slice(0, length))
+	}
 	for i := 0; i < length; i++ {
 		value := reflect.New(destValue).Elem()
-		if err := _RecursiveMirror(source.Index(i), value, bestEffort); err != nil {
-			if bestEffort {
+		ctx.PushIndex(i)
+		err := _RecursiveMirror(source.Index(i), value, ctx)
+		ctx.Pop()
+		if err != nil {
+			if ctx.Skip(err) {
 				continue
 			}
 			return err
@@ -1,23 +1,49 @@
 package mirror
 
 import (
-	"errors"
 	"reflect"
 )
 
 //Handle conversion for map dest
-func _HandleMap(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
+//Nil destination map will be allocated, see [WithReuseContainers]
+func _HandleMap(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if sourceKind != reflect.Map && sourceKind != reflect.Struct {
+		return ctx.NewError(source, dest, "Map", ErrTypeMismatch)
+	}
+	//Reuse map created for the same source so cycle terminate
+	//Destination that is reused keep it's own content so it never take visited map
+	fresh := dest.IsNil() || !ctx.config.reuseContainers
+	var key _VisitKey
+	trackable := false
+	if sourceKind == reflect.Map {
+		key, trackable = _NewVisitKey(source, dest.Type())
+		if visited, ok := ctx.Visited(key); fresh && trackable && ok {
+			dest.Set(visited)
+			return nil
+		}
+	}
+	if fresh {
+		size := 0
+		if sourceKind == reflect.Map {
+			size = source.Len()
+		} else {
+			size = source.NumField()
+		}
+		dest.Set(reflect.MakeMapWithSize(dest.Type(), size))
+	}
+	//Only empty map is remembered, reused map with content must not be handed to other field
+	if trackable && dest.Len() == 0 {
+		ctx.Visit(key, dest)
+	}
 	if sourceKind == reflect.Map {
-		return _HandleMapToMap(source, dest, bestEffort)
-	} else if sourceKind == reflect.Struct {
-		return _HandleStructToMap(source, dest, bestEffort)
+		return _HandleMapToMap(source, dest, ctx)
 	}
-	return errors.New("Destination field type didn't match Source field type")
+	return _HandleStructToMap(source, dest, ctx)
 }
 
 //Handle conversion for map to map
 //Basically copy source to destination but allow only correct key and value
-func _HandleMapToMap(source, dest reflect.Value, bestEffort bool) error {
+func _HandleMapToMap(source, dest reflect.Value, ctx *_MirrorContext) error {
 	mapEntry := source.MapRange()
 	destType := dest.Type()
 	destKey := destType.Key()
@@ -25,17 +51,22 @@ func _HandleMapToMap(source, dest reflect.Value, bestEffort bool) error {
 	for mapEntry.Next() {
 		key := reflect.New(destKey).Elem()
 		value := reflect.New(destValue).Elem()
-		if err := _RecursiveMirror(mapEntry.Key(), key, bestEffort); err != nil {
-			if bestEffort {
+		ctx.PushKey(mapEntry.Key())
+		if err := _RecursiveMirror(mapEntry.Key(), key, ctx); err != nil {
+			ctx.Pop()
+			if ctx.Skip(err) {
 				continue
 			}
 			return err
 		}
 		if key.IsZero() {
+			ctx.Pop()
 			continue
 		}
-		if err := _RecursiveMirror(mapEntry.Value(), value, bestEffort); err != nil {
-			if bestEffort {
+		err := _RecursiveMirror(mapEntry.Value(), value, ctx)
+		ctx.Pop()
+		if err != nil {
+			if ctx.Skip(err) {
 				continue
 			}
 			return err
@@ -47,26 +78,40 @@ func _HandleMapToMap(source, dest reflect.Value, bestEffort bool) error {
 
 //Handle conversion for struct to map
 //Basically copy struct field to destination but allow only correct key and value
-func _HandleStructToMap(source, dest reflect.Value, bestEffort bool) error {
-	sourceType := source.Type()
-	numField := source.NumField()
+func _HandleStructToMap(source, dest reflect.Value, ctx *_MirrorContext) error {
+	plan := ctx.config.plans.Get(source.Type(), dest.Type(), ctx.config, _CompileStructToMap)
 	destType := dest.Type()
 	destKey := destType.Key()
 	destValue := destType.Elem()
-	for i := 0; i < numField; i++ {
-		sourceField := source.Field(i)
-		sourceName := sourceType.Field(i).Name
-
-		key := reflect.New(destKey).Elem()
-		value := reflect.New(destValue).Elem()
-		if err := _RecursiveMirror(reflect.ValueOf(sourceName), key, bestEffort); err != nil {
-			return err
-		}
-		if key.IsZero() {
+	directKey := !ctx.converters
+	for i := range plan.fields {
+		field := &plan.fields[i]
+		sourceField := source.Field(field.index)
+		if field.omitEmpty && sourceField.IsZero() {
 			continue
 		}
-		if err := _RecursiveMirror(sourceField, value, bestEffort); err != nil {
-			if bestEffort {
+
+		ctx.PushField(field.fieldName, field.options)
+		key := field.key
+		if !key.IsValid() || !directKey {
+			key = reflect.New(destKey).Elem()
+			if err := _RecursiveMirror(reflect.ValueOf(field.name), key, ctx); err != nil {
+				ctx.Pop()
+				if ctx.Collect(err) {
+					continue
+				}
+				return err
+			}
+			if key.IsZero() {
+				ctx.Pop()
+				continue
+			}
+		}
+		value := reflect.New(destValue).Elem()
+		err := _RecursiveMirror(sourceField, value, ctx)
+		ctx.Pop()
+		if err != nil {
+			if ctx.Skip(err) {
 				continue
 			}
 			return err
@@ -164,7 +164,7 @@ func TestStructToMap(t *testing.T) {
 		}
 		destination := map[string]int{}
 		hasError := false
-		err := SmartMirror(&source, &destination)
+		err := SmartMirror(&source, &destination, WithRounding(RoundTruncate))
 		if err != nil {
 			if !hasError {
 				t.Errorf("Got an error %s", err.Error())
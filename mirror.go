@@ -1,60 +1,139 @@
 package mirror
 
 import (
-	"errors"
+	"fmt"
 	"reflect"
+	"sort"
+	"sync"
 )
 
-type _RecursiveMirrorJumpTableFunc func(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error
+type _RecursiveMirrorJumpTableFunc func(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error
+
+//Hold state shared by a single mirror call
+type _MirrorContext struct {
+	bestEffort  bool
+	config      *_Config
+	converters  bool //Whether any converter is registered, checked once per call
+	path        []_PathSegment
+	errs        MirrorErrors
+	visited     map[_VisitKey]reflect.Value
+	visitedList []_VisitEntry
+	root        reflect.Value
+	rootDest    reflect.Value
+	rootKey     _VisitKey
+	rootKeySet  bool
+	nodes       int
+}
+
+//Context is reused between call since most call only mirror a handful of value
+var _ContextPool = sync.Pool{
+	New: func() interface{} {
+		return &_MirrorContext{}
+	},
+}
+
+//Get an empty context for a single mirror call
+func _AcquireContext(config *_Config) *_MirrorContext {
+	ctx := _ContextPool.Get().(*_MirrorContext)
+	ctx.bestEffort = config.bestEffort
+	ctx.config = config
+	ctx.converters = config.registry.hasConverter()
+	return ctx
+}
+
+//Return context to the pool, reference to mirrored value is dropped so it can be collected
+func (ctx *_MirrorContext) Release() {
+	path := ctx.path[:cap(ctx.path)]
+	for i := range path {
+		path[i] = _PathSegment{}
+	}
+	visitedList := ctx.visitedList[:cap(ctx.visitedList)]
+	for i := range visitedList {
+		visitedList[i] = _VisitEntry{}
+	}
+	ctx.path = path[:0]
+	ctx.visitedList = visitedList[:0]
+	ctx.config = nil
+	ctx.errs = nil
+	ctx.visited = nil
+	ctx.root = reflect.Value{}
+	ctx.rootDest = reflect.Value{}
+	ctx.rootKey = _VisitKey{}
+	ctx.rootKeySet = false
+	ctx.nodes = 0
+	_ContextPool.Put(ctx)
+}
 
 var jumpTableRecursiveMirror map[reflect.Kind]_RecursiveMirrorJumpTableFunc
 
 func init() {
 	jumpTableRecursiveMirror = map[reflect.Kind]_RecursiveMirrorJumpTableFunc{
-		reflect.Bool:      _HandleBool,
-		reflect.Int:       _HandleInt,
-		reflect.Int8:      _HandleInt,
-		reflect.Int16:     _HandleInt,
-		reflect.Int32:     _HandleInt,
-		reflect.Int64:     _HandleInt,
-		reflect.Uint:      _HandleUint,
-		reflect.Uint8:     _HandleUint,
-		reflect.Uint16:    _HandleUint,
-		reflect.Uint32:    _HandleUint,
-		reflect.Uint64:    _HandleUint,
-		reflect.Float32:   _HandleFloat,
-		reflect.Float64:   _HandleFloat,
-		reflect.Slice:     _HandleList,
-		reflect.Array:     _HandleList,
-		reflect.String:    _HandleString,
-		reflect.Map:       _HandleMap,
-		reflect.Struct:    _HandleStruct,
-		reflect.Interface: _HandleInterface,
-		reflect.Ptr:       _HandlePointer,
-	}
-}
-
-func _RecursiveMirror(source, dest reflect.Value, bestEffort bool) error {
+		reflect.Bool:       _HandleBool,
+		reflect.Int:        _HandleInt,
+		reflect.Int8:       _HandleInt,
+		reflect.Int16:      _HandleInt,
+		reflect.Int32:      _HandleInt,
+		reflect.Int64:      _HandleInt,
+		reflect.Uint:       _HandleUint,
+		reflect.Uint8:      _HandleUint,
+		reflect.Uint16:     _HandleUint,
+		reflect.Uint32:     _HandleUint,
+		reflect.Uint64:     _HandleUint,
+		reflect.Uintptr:    _HandleUint,
+		reflect.Float32:    _HandleFloat,
+		reflect.Float64:    _HandleFloat,
+		reflect.Complex64:  _HandleComplex,
+		reflect.Complex128: _HandleComplex,
+		reflect.Slice:      _HandleList,
+		reflect.Array:      _HandleArray,
+		reflect.String:     _HandleString,
+		reflect.Map:        _HandleMap,
+		reflect.Struct:     _HandleStruct,
+		reflect.Interface:  _HandleInterface,
+		reflect.Ptr:        _HandlePointer,
+	}
+}
+
+//Mirror source into dest
+//Panic is recovered once by [_Mirror], except when error is collected so mirroring can continue past the failed value
+func _RecursiveMirror(source, dest reflect.Value, ctx *_MirrorContext) error {
+	if ctx.config.collectErrors {
+		return _RecoverMirrorValue(source, dest, ctx)
+	}
+	return _MirrorValue(source, dest, ctx)
+}
+
+//Mirror source into dest, any panic raised by reflect will be returned as error
+func _RecoverMirrorValue(source, dest reflect.Value, ctx *_MirrorContext) (err error) {
+	depth := len(ctx.path)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			ctx.path = ctx.path[:depth]
+			err = ctx.NewError(source, dest, "Mirror", fmt.Errorf("%w, %v", ErrPanic, recovered))
+		}
+	}()
+	return _MirrorValue(source, dest, ctx)
+}
+
+func _MirrorValue(source, dest reflect.Value, ctx *_MirrorContext) error {
 
 	destKind := dest.Kind()
 	sourceKind := source.Kind()
 
+	if err := ctx.CheckLimit(source, dest); err != nil {
+		return err
+	}
+
 	switch sourceKind {
 	case reflect.Invalid:
-		if bestEffort {
-			return nil
-		}
-		return errors.New("Destination field type didn't match Source field type")
-	case reflect.Slice, reflect.Map:
-		if source.IsNil() {
+		if ctx.bestEffort {
 			return nil
 		}
-	case reflect.Ptr:
+		return ctx.NewError(source, dest, "Mirror", ErrTypeMismatch)
+	case reflect.Slice, reflect.Map, reflect.Ptr:
 		if source.IsNil() {
 			return nil
 		}
-		source = source.Elem()
-		return _RecursiveMirror(source, dest, bestEffort)
 	default:
 	}
 
@@ -62,24 +141,48 @@ func _RecursiveMirror(source, dest reflect.Value, bestEffort bool) error {
 		return nil
 	}
 
+	if converter, value, ok := ctx.FindConverter(source, dest); ok {
+		return ctx.Convert(converter, value, dest)
+	}
+
+	if sourceKind == reflect.Ptr {
+		return _RecursiveMirror(source.Elem(), dest, ctx)
+	}
+
 	sourceType := source.Type()
 	destType := dest.Type()
-	if sourceType == destType {
+	if sourceType == destType && !ctx.MustMirror(dest, ctx.FieldTag()) {
+		if ctx.config.deepCopy && _HasReference(sourceType) {
+			return _DeepCopy(source, dest, ctx)
+		}
 		dest.Set(source)
 		return nil
 	}
 
 	if sourceKind == reflect.Interface {
-		return _RecursiveMirror(source.Elem(), dest, bestEffort)
+		return _RecursiveMirror(source.Elem(), dest, ctx)
 	}
 
+	if ctx.bestEffort {
+		if destType == _TimeType || sourceType == _TimeType {
+			return _HandleTime(source, dest, sourceKind, destKind, ctx)
+		}
+		if destType == _DurationType || sourceType == _DurationType {
+			return _HandleDuration(source, dest, sourceKind, destKind, ctx)
+		}
+	}
+	return _HandleKind(source, dest, sourceKind, destKind, ctx)
+}
+
+//Mirror using handler of the destination kind
+func _HandleKind(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
 	if handler, ok := jumpTableRecursiveMirror[destKind]; ok {
-		return handler(source, dest, sourceKind, destKind, bestEffort)
+		return handler(source, dest, sourceKind, destKind, ctx)
 	}
-	return errors.New("Destination field type didn't match Source field type")
+	return ctx.NewError(source, dest, "Mirror", ErrTypeMismatch)
 }
 
-func _Mirror(source, destination interface{}, bestEffort bool) error {
+func _Mirror(source, destination interface{}, config *_Config) error {
 	src := reflect.ValueOf(source)
 	dest := reflect.ValueOf(destination)
 	if dest.Kind() == reflect.Ptr {
@@ -88,20 +191,63 @@ func _Mirror(source, destination interface{}, bestEffort bool) error {
 	if src.Kind() == reflect.Ptr {
 		src = src.Elem()
 	}
+	ctx := _AcquireContext(config)
+	defer ctx.Release()
 	if !dest.CanSet() {
-		return errors.New("Destination is not set-able, are you passing non pointer value?")
+		return ctx.NewError(src, dest, "Mirror", ErrNotSettable)
+	}
+	//Pointer to the root source should point to the root destination
+	if src.IsValid() && dest.CanAddr() {
+		ctx.root = src
+		ctx.rootDest = dest
 	}
-	return _RecursiveMirror(src, dest, bestEffort)
+	if err := _RecoverMirror(src, dest, ctx); err != nil && !ctx.Collect(err) {
+		return err
+	}
+	if len(ctx.errs) > 0 {
+		sort.SliceStable(ctx.errs, func(i, j int) bool {
+			return ctx.errs[i].Path < ctx.errs[j].Path
+		})
+		return ctx.errs
+	}
+	return nil
+}
+
+//Mirror root value, any panic raised by reflect will be returned as error
+//Path of the context still point to the value that panic since it's not popped
+func _RecoverMirror(source, dest reflect.Value, ctx *_MirrorContext) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = ctx.NewError(reflect.Value{}, reflect.Value{}, "Mirror", fmt.Errorf("%w, %v", ErrPanic, recovered))
+		}
+	}()
+	return _RecursiveMirror(source, dest, ctx)
 }
 
 //Convert arbitrary interface to certain structure
 //Will NOT attemp to convert data type, see [SmartMirror]
-func Mirror(source, destination interface{}) error {
-	return _Mirror(source, destination, false)
+func Mirror(source, destination interface{}, options ...Option) error {
+	return _DefaultInstance.Mirror(source, destination, options...)
 }
 
 //Convert arbitrary interface to certain structure
 //Will also attemp to convert data type to best match the destination
-func SmartMirror(source, destination interface{}) error {
-	return _Mirror(source, destination, true)
+func SmartMirror(source, destination interface{}, options ...Option) error {
+	return _DefaultInstance.SmartMirror(source, destination, options...)
+}
+
+//Convert arbitrary interface to certain structure while duplicating every slice, map and pointer
+//Will NOT attemp to convert data type, see [SmartMirror] and [WithDeepCopy]
+func DeepMirror(source, destination interface{}, options ...Option) error {
+	return _DefaultInstance.DeepMirror(source, destination, options...)
+}
+
+//Create a deep copy of value, see [DeepMirror]
+func Clone(value interface{}) (interface{}, error) {
+	return _DefaultInstance.Clone(value)
+}
+
+//Register converter to the default instance used by Mirror and SmartMirror
+func RegisterConverter(sourceType, destType reflect.Type, converter ConverterFunc) {
+	_DefaultInstance.config.registry.Register(sourceType, destType, converter)
 }
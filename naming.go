@@ -0,0 +1,76 @@
+package mirror
+
+import (
+	"strings"
+	"unicode"
+)
+
+//NameStrategy convert a struct field name into a key name
+//It's applied to field without explicit tag name when mirroring struct from or into map
+type NameStrategy func(name string) string
+
+//Keep field name as is, this is the default strategy
+func ExactCase(name string) string {
+	return name
+}
+
+//Convert field name into snake_case, ex : UserID -> user_id
+func SnakeCase(name string) string {
+	return strings.ToLower(strings.Join(_SplitWords(name), "_"))
+}
+
+//Convert field name into SCREAMING_SNAKE_CASE, ex : UserID -> USER_ID
+func ScreamingSnakeCase(name string) string {
+	return strings.ToUpper(strings.Join(_SplitWords(name), "_"))
+}
+
+//Convert field name into kebab-case, ex : UserID -> user-id
+func KebabCase(name string) string {
+	return strings.ToLower(strings.Join(_SplitWords(name), "-"))
+}
+
+//Convert field name into camelCase, ex : UserID -> userId
+func CamelCase(name string) string {
+	words := _SplitWords(name)
+	for i, word := range words {
+		word = strings.ToLower(word)
+		if i > 0 {
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			word = string(runes)
+		}
+		words[i] = word
+	}
+	return strings.Join(words, "")
+}
+
+//Split name into words by case change and separator
+//Acronym is kept as a single word, ex : HTTPServerID -> HTTP Server ID
+func _SplitWords(name string) []string {
+	words := make([]string, 0, 4)
+	runes := []rune(name)
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		current := runes[i]
+		if current == '_' || current == '-' || unicode.IsSpace(current) {
+			if i > start {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		}
+		if i == start || !unicode.IsUpper(current) {
+			continue
+		}
+		previous := runes[i-1]
+		nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
@@ -0,0 +1,77 @@
+package mirror
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameStrategy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Strategy NameStrategy
+		Input    string
+		Expect   string
+	}{
+		{"Snake", SnakeCase, "FirstName", "first_name"},
+		{"SnakeAcronym", SnakeCase, "HTTPServerID", "http_server_id"},
+		{"SnakeDigit", SnakeCase, "Address2Line", "address2_line"},
+		{"ScreamingSnake", ScreamingSnakeCase, "UserID", "USER_ID"},
+		{"Kebab", KebabCase, "FirstName", "first-name"},
+		{"Camel", CamelCase, "UserID", "userId"},
+		{"CamelSingle", CamelCase, "Name", "name"},
+		{"Exact", ExactCase, "UserID", "UserID"},
+	}
+
+	for _, val := range testData {
+		t.Run(val.Name, func(t *testing.T) {
+			assert.Equal(t, val.Expect, val.Strategy(val.Input))
+		})
+	}
+}
+
+func TestNameStrategyMirror(t *testing.T) {
+	type Person struct {
+		FirstName string
+		UserID    int
+		Nickname  string `mirror:"nick"`
+	}
+
+	t.Run("StructToMap", func(t *testing.T) {
+		source := Person{FirstName: "Rendoru", UserID: 1, Nickname: "Doru"}
+		dest := map[string]interface{}{}
+		if err := Mirror(&source, &dest, WithNameStrategy(SnakeCase)); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, map[string]interface{}{
+			"first_name": "Rendoru",
+			"user_id":    1,
+			"nick":       "Doru",
+		}, dest)
+	})
+
+	t.Run("MapToStruct", func(t *testing.T) {
+		source := map[string]interface{}{
+			"first-name": "Rendoru",
+			"user-id":    1,
+			"nick":       "Doru",
+		}
+		dest := Person{}
+		if err := Mirror(&source, &dest, WithNameStrategy(KebabCase)); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, Person{FirstName: "Rendoru", UserID: 1, Nickname: "Doru"}, dest)
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		source := Person{FirstName: "Rendoru"}
+		dest := map[string]interface{}{}
+		prefix := func(name string) string {
+			return "x_" + SnakeCase(name)
+		}
+		if err := Mirror(&source, &dest, WithNameStrategy(prefix)); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, "Rendoru", dest["x_first_name"])
+	})
+}
@@ -3,91 +3,266 @@ package mirror
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
-func _HandleInt(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
-	if !bestEffort {
+//RoundingPolicy decide how float with fractional part is converted into integer
+type RoundingPolicy int
+
+const (
+	//Discard fractional part, 1.9 become 1 and -1.9 become -1
+	RoundTruncate RoundingPolicy = iota + 1
+	//Round to nearest, tie is rounded to even number, 2.5 become 2
+	RoundHalfEven
+	//Round to nearest, tie is rounded away from zero, 2.5 become 3
+	RoundHalfAway
+	//Round toward negative infinity
+	RoundFloor
+	//Round toward positive infinity
+	RoundCeil
+	//Return [ErrPrecisionLoss] if float has fractional part, it's the default
+	RoundReject
+)
+
+func _ParseRoundingPolicy(raw string) RoundingPolicy {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "truncate":
+		return RoundTruncate
+	case "even":
+		return RoundHalfEven
+	case "away":
+		return RoundHalfAway
+	case "floor":
+		return RoundFloor
+	case "ceil":
+		return RoundCeil
+	case "reject":
+		return RoundReject
+	}
+	return 0
+}
+
+func _HandleInt(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if !ctx.bestEffort {
 		if destKind != sourceKind {
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Int", ErrTypeMismatch)
 		}
 		dest.Set(source)
 	} else {
 		switch sourceKind {
-		case reflect.Int:
-			dest.Set(source)
-		case reflect.Uint:
-			dest.SetInt(int64(source.Uint()))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return _SetInt(source, dest, source.Int(), ctx)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			number := source.Uint()
+			if number > math.MaxInt64 && !ctx.config.wrapNumbers {
+				return ctx.NewError(source, dest, "Int", _NewOverflowError(number, dest))
+			}
+			return _SetInt(source, dest, int64(number), ctx)
 		case reflect.Float32, reflect.Float64:
-			dest.SetInt(int64(source.Float()))
+			return _SetIntFromFloat(source, dest, source.Float(), ctx)
 		case reflect.String:
 			rawString := source.String()
-			number, err := strconv.ParseInt(rawString, 10, 0)
-			if err != nil {
-				return fmt.Errorf("Failed to mirror String to Int, err : %s", err.Error())
+			number, err := _ParseIntString(rawString, ctx.config.numberSyntax)
+			if err == nil {
+				return _SetInt(source, dest, number, ctx)
+			}
+			if float, ok := _ParseIntegerFloatString(rawString, err, ctx.config.numberSyntax); ok {
+				return _SetIntFromFloat(source, dest, float, ctx)
 			}
-			dest.SetInt(number)
+			return ctx.NewError(source, dest, "Int", _NewNumberParseError(rawString, dest, err))
 		default:
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Int", ErrTypeMismatch)
 		}
 	}
 	return nil
 }
 
-func _HandleUint(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
-	if !bestEffort {
+func _HandleUint(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if !ctx.bestEffort {
 		if destKind != sourceKind {
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Uint", ErrTypeMismatch)
 		}
 		dest.Set(source)
 	} else {
 		switch sourceKind {
-		case reflect.Uint:
-			dest.Set(source)
-		case reflect.Int:
-			dest.SetUint(uint64(source.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return _SetUint(source, dest, source.Uint(), ctx)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			number := source.Int()
+			if number < 0 && !ctx.config.wrapNumbers {
+				return ctx.NewError(source, dest, "Uint", _NewOverflowError(number, dest))
+			}
+			return _SetUint(source, dest, uint64(number), ctx)
 		case reflect.Float32, reflect.Float64:
-			dest.SetUint(uint64(source.Float()))
+			return _SetUintFromFloat(source, dest, source.Float(), ctx)
 		case reflect.String:
 			rawString := source.String()
-			number, err := strconv.ParseUint(rawString, 10, 0)
-			if err != nil {
-				return fmt.Errorf("Failed to mirror String to Int, err : %s", err.Error())
+			number, err := _ParseUintString(rawString, ctx.config.numberSyntax)
+			if err == nil {
+				return _SetUint(source, dest, number, ctx)
 			}
-			dest.SetUint(number)
+			if float, ok := _ParseIntegerFloatString(rawString, err, ctx.config.numberSyntax); ok {
+				return _SetUintFromFloat(source, dest, float, ctx)
+			}
+			return ctx.NewError(source, dest, "Uint", _NewNumberParseError(rawString, dest, err))
 		default:
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Uint", ErrTypeMismatch)
 		}
 	}
 	return nil
 }
 
-func _HandleFloat(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
-	if !bestEffort {
+func _HandleFloat(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if !ctx.bestEffort {
 		if destKind != sourceKind {
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Float", ErrTypeMismatch)
 		}
 		dest.Set(source)
 	} else {
 
 		switch sourceKind {
 		case reflect.Float32, reflect.Float64:
-			dest.Set(source)
-		case reflect.Int:
-			dest.SetFloat(float64(source.Int()))
-		case reflect.Uint:
-			dest.SetFloat(float64(source.Uint()))
+			return _SetFloat(source, dest, source.Float(), ctx)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			number := source.Int()
+			float := _FloatOfKind(float64(number), destKind)
+			if !ctx.config.wrapNumbers && (float >= 1<<63 || int64(float) != number) {
+				return ctx.NewError(source, dest, "Float", _NewPrecisionLossError(number, dest))
+			}
+			dest.SetFloat(float)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			number := source.Uint()
+			float := _FloatOfKind(float64(number), destKind)
+			if !ctx.config.wrapNumbers && (float >= 1<<64 || uint64(float) != number) {
+				return ctx.NewError(source, dest, "Float", _NewPrecisionLossError(number, dest))
+			}
+			dest.SetFloat(float)
 		case reflect.String:
 			rawString := source.String()
-			number, err := strconv.ParseFloat(rawString, 0)
+			number, err := _ParseFloatString(rawString, ctx.config.numberSyntax)
 			if err != nil {
-				return fmt.Errorf("Failed to mirror String to Int, err : %s", err.Error())
+				return ctx.NewError(source, dest, "Float", _NewNumberParseError(rawString, dest, err))
 			}
-			dest.SetFloat(number)
+			return _SetFloat(source, dest, number, ctx)
 		default:
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "Float", ErrTypeMismatch)
 		}
 	}
 	return nil
 }
+
+//Set number into int dest, number that doesn't fit is an error unless wrapping is allowed
+func _SetInt(source, dest reflect.Value, number int64, ctx *_MirrorContext) error {
+	if dest.OverflowInt(number) && !ctx.config.wrapNumbers {
+		return ctx.NewError(source, dest, "Int", _NewOverflowError(number, dest))
+	}
+	dest.SetInt(number)
+	return nil
+}
+
+//Set number into uint dest, number that doesn't fit is an error unless wrapping is allowed
+func _SetUint(source, dest reflect.Value, number uint64, ctx *_MirrorContext) error {
+	if dest.OverflowUint(number) && !ctx.config.wrapNumbers {
+		return ctx.NewError(source, dest, "Uint", _NewOverflowError(number, dest))
+	}
+	dest.SetUint(number)
+	return nil
+}
+
+//Round float and set it into int dest, see [RoundingPolicy]
+func _SetIntFromFloat(source, dest reflect.Value, float float64, ctx *_MirrorContext) error {
+	number, err := _RoundFloat(float, dest, ctx)
+	if err != nil {
+		return ctx.NewError(source, dest, "Int", err)
+	}
+	if !ctx.config.wrapNumbers {
+		if err := _CheckFloatRange(number, -(1 << 63), 1<<63, dest); err != nil {
+			return ctx.NewError(source, dest, "Int", err)
+		}
+	}
+	return _SetInt(source, dest, int64(number), ctx)
+}
+
+//Round float and set it into uint dest, see [RoundingPolicy]
+func _SetUintFromFloat(source, dest reflect.Value, float float64, ctx *_MirrorContext) error {
+	number, err := _RoundFloat(float, dest, ctx)
+	if err != nil {
+		return ctx.NewError(source, dest, "Uint", err)
+	}
+	if !ctx.config.wrapNumbers {
+		if err := _CheckFloatRange(number, 0, 1<<64, dest); err != nil {
+			return ctx.NewError(source, dest, "Uint", err)
+		}
+	}
+	return _SetUint(source, dest, uint64(number), ctx)
+}
+
+//Set number into float dest, finite number that doesn't fit is an error unless wrapping is allowed
+func _SetFloat(source, dest reflect.Value, number float64, ctx *_MirrorContext) error {
+	if dest.OverflowFloat(number) && !ctx.config.wrapNumbers {
+		return ctx.NewError(source, dest, "Float", _NewOverflowError(number, dest))
+	}
+	dest.SetFloat(number)
+	return nil
+}
+
+//Round float before it's converted to integer using policy of the field or the call
+func _RoundFloat(number float64, dest reflect.Value, ctx *_MirrorContext) (float64, error) {
+	policy := ctx.config.rounding
+	if tag := ctx.InheritedTag(); tag != nil && tag.rounding != 0 {
+		policy = tag.rounding
+	}
+	switch policy {
+	case RoundHalfEven:
+		return math.RoundToEven(number), nil
+	case RoundHalfAway:
+		return math.Round(number), nil
+	case RoundFloor:
+		return math.Floor(number), nil
+	case RoundCeil:
+		return math.Ceil(number), nil
+	case RoundReject, 0:
+		if number != math.Trunc(number) && !math.IsNaN(number) {
+			return 0, fmt.Errorf("%w, %v has fractional part and can't be stored in %v", ErrPrecisionLoss, number, dest.Type())
+		}
+	}
+	return number, nil
+}
+
+//Check that float is within [min, max) before it's converted to integer
+//Fractional part is allowed since it will be discarded
+func _CheckFloatRange(number, min, max float64, dest reflect.Value) error {
+	if math.IsNaN(number) || math.IsInf(number, 0) || math.Trunc(number) < min || number >= max {
+		return _NewOverflowError(number, dest)
+	}
+	return nil
+}
+
+//Create overflow error cause for number that doesn't fit in dest
+func _NewOverflowError(number interface{}, dest reflect.Value) error {
+	return fmt.Errorf("%w, %v doesn't fit in %v", ErrOverflow, number, dest.Type())
+}
+
+//Create precision loss error cause for integer that can't be represented exactly by float dest
+func _NewPrecisionLossError(number interface{}, dest reflect.Value) error {
+	return fmt.Errorf("%w, %v can't be represented exactly in %v", ErrPrecisionLoss, number, dest.Type())
+}
+
+//Round float into precision of the float kind
+func _FloatOfKind(number float64, kind reflect.Kind) float64 {
+	if kind == reflect.Float32 {
+		return float64(float32(number))
+	}
+	return number
+}
+
+//Create parse error cause, number that is out of range is reported as overflow
+func _NewNumberParseError(rawString string, dest reflect.Value, err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return _NewOverflowError(rawString, dest)
+	}
+	return _NewParseError(err)
+}
@@ -0,0 +1,370 @@
+package mirror
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberOverflow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   interface{}
+		dest     interface{}
+		expected interface{}
+	}{
+		{"IntToInt8", 300, new(int8), nil},
+		{"IntToInt8Fit", -128, new(int8), int8(-128)},
+		{"NegativeToUint", -1, new(uint), nil},
+		{"NegativeToUint8", -1, new(uint8), nil},
+		{"UintToInt", uint(math.MaxUint64), new(int), nil},
+		{"UintToInt16", uint(70000), new(int16), nil},
+		{"UintToUint8", uint(256), new(uint8), nil},
+		{"FloatToInt8", 128.0, new(int8), nil},
+		{"FloatToInt", 1e19, new(int), nil},
+		{"FloatToUint", -1.0, new(uint), nil},
+		{"FloatToUintFit", 255.0, new(uint8), uint8(255)},
+		{"NaNToInt", math.NaN(), new(int), nil},
+		{"InfToUint", math.Inf(1), new(uint), nil},
+		{"FloatToFloat32", 1e39, new(float32), nil},
+		{"InfToFloat32", math.Inf(-1), new(float32), float32(math.Inf(-1))},
+		{"StringToInt8", "128", new(int8), nil},
+		{"StringToInt", "9223372036854775808", new(int), nil},
+		{"StringToUint16", "65536", new(uint16), nil},
+		{"StringToFloat32", "1e39", new(float32), nil},
+		{"StringToFloat", "1e309", new(float64), nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			source := testCase.source
+			err := SmartMirror(&source, testCase.dest)
+			if testCase.expected != nil {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expected, reflect.ValueOf(testCase.dest).Elem().Interface())
+				return
+			}
+			mirrorErr := &MirrorError{}
+			if assert.True(t, errors.As(err, &mirrorErr)) {
+				assert.True(t, errors.Is(err, ErrOverflow))
+			}
+		})
+	}
+}
+
+func TestNumberWrapping(t *testing.T) {
+	source := map[string]interface{}{"Age": -1, "Small": 300}
+	dest := struct {
+		Age   uint
+		Small int8
+	}{}
+	assert.NoError(t, SmartMirror(&source, &dest, WithNumberWrapping()))
+	assert.Equal(t, uint(math.MaxUint64), dest.Age)
+	assert.Equal(t, int8(44), dest.Small)
+}
+
+func TestNumberOverflowNotSkipped(t *testing.T) {
+	source := []interface{}{1, "A", 300}
+	dest := []int8{}
+	err := SmartMirror(&source, &dest)
+	mirrorErr := &MirrorError{}
+	if assert.True(t, errors.As(err, &mirrorErr)) {
+		assert.Equal(t, "[2]", mirrorErr.Path)
+		assert.True(t, errors.Is(err, ErrOverflow))
+	}
+
+	dest = []int8{}
+	err = SmartMirror(&source, &dest, WithCollectErrors())
+	mirrorErrs := MirrorErrors{}
+	if assert.True(t, errors.As(err, &mirrorErrs)) {
+		assert.Len(t, mirrorErrs, 2)
+	}
+	assert.Equal(t, []int8{1}, dest)
+}
+
+func TestIntToFloatPrecision(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   interface{}
+		dest     interface{}
+		expected interface{}
+	}{
+		{"Exact", int64(1 << 53), new(float64), float64(1 << 53)},
+		{"Inexact", int64(1<<53 + 1), new(float64), nil},
+		{"MaxInt64", int64(math.MaxInt64), new(float64), nil},
+		{"MinInt64", int64(math.MinInt64), new(float64), float64(math.MinInt64)},
+		{"UintInexact", uint64(math.MaxUint64), new(float64), nil},
+		{"Float32Exact", 1 << 24, new(float32), float32(1 << 24)},
+		{"Float32Inexact", 1<<24 + 1, new(float32), nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			source := testCase.source
+			err := SmartMirror(&source, testCase.dest)
+			if testCase.expected != nil {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expected, reflect.ValueOf(testCase.dest).Elem().Interface())
+				return
+			}
+			assert.True(t, errors.Is(err, ErrPrecisionLoss))
+			assert.NoError(t, SmartMirror(&source, testCase.dest, WithNumberWrapping()))
+		})
+	}
+}
+
+func TestRoundingPolicy(t *testing.T) {
+	source := []float64{2.5, -2.5, 1.9999999, -1.1}
+	testCases := []struct {
+		name     string
+		policy   RoundingPolicy
+		expected []int
+	}{
+		{"Truncate", RoundTruncate, []int{2, -2, 1, -1}},
+		{"HalfEven", RoundHalfEven, []int{2, -2, 2, -1}},
+		{"HalfAway", RoundHalfAway, []int{3, -3, 2, -1}},
+		{"Floor", RoundFloor, []int{2, -3, 1, -2}},
+		{"Ceil", RoundCeil, []int{3, -2, 2, -1}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := []int{}
+			assert.NoError(t, SmartMirror(&source, &dest, WithRounding(testCase.policy)))
+			assert.Equal(t, testCase.expected, dest)
+		})
+	}
+
+	for name, policy := range map[string]RoundingPolicy{"Default": 0, "Reject": RoundReject} {
+		policy := policy
+		t.Run(name, func(t *testing.T) {
+			dest := []int{}
+			err := SmartMirror(&source, &dest, WithRounding(policy))
+			mirrorErr := &MirrorError{}
+			if assert.True(t, errors.As(err, &mirrorErr)) {
+				assert.Equal(t, "[0]", mirrorErr.Path)
+				assert.True(t, errors.Is(err, ErrPrecisionLoss))
+			}
+			integral := []float64{2, -3}
+			dest = []int{}
+			assert.NoError(t, SmartMirror(&integral, &dest, WithRounding(policy)))
+			assert.Equal(t, []int{2, -3}, dest)
+		})
+	}
+
+	t.Run("Truncate", func(t *testing.T) {
+		value := 2.9
+		dest := 0
+		assert.True(t, errors.Is(SmartMirror(&value, &dest), ErrPrecisionLoss))
+		assert.NoError(t, SmartMirror(&value, &dest, WithRounding(RoundTruncate)))
+		assert.Equal(t, 2, dest)
+	})
+
+	t.Run("Uint", func(t *testing.T) {
+		value := 2.5
+		dest := uint(0)
+		assert.NoError(t, SmartMirror(&value, &dest, WithRounding(RoundCeil)))
+		assert.Equal(t, uint(3), dest)
+	})
+}
+
+func TestRoundingTag(t *testing.T) {
+	type Invoice struct {
+		Quantity int   `mirror:"quantity,round=reject"`
+		Pages    []int `mirror:"pages,round=ceil"`
+		Total    int   `mirror:"total"`
+	}
+	source := map[string]interface{}{
+		"quantity": 3.0,
+		"pages":    []interface{}{1.2, 2.0},
+		"total":    9.5,
+	}
+	dest := Invoice{}
+	assert.NoError(t, SmartMirror(&source, &dest, WithRounding(RoundHalfAway)))
+	assert.Equal(t, Invoice{3, []int{2, 2}, 10}, dest)
+
+	source["quantity"] = 3.5
+	err := SmartMirror(&source, &dest, WithRounding(RoundHalfAway))
+	mirrorErr := &MirrorError{}
+	if assert.True(t, errors.As(err, &mirrorErr)) {
+		assert.Equal(t, "Quantity", mirrorErr.Path)
+		assert.True(t, errors.Is(err, ErrPrecisionLoss))
+	}
+}
+
+var numericTypes = []reflect.Type{
+	reflect.TypeOf(int(0)), reflect.TypeOf(int8(0)), reflect.TypeOf(int16(0)), reflect.TypeOf(int32(0)), reflect.TypeOf(int64(0)),
+	reflect.TypeOf(uint(0)), reflect.TypeOf(uint8(0)), reflect.TypeOf(uint16(0)), reflect.TypeOf(uint32(0)), reflect.TypeOf(uint64(0)),
+	reflect.TypeOf(uintptr(0)), reflect.TypeOf(float32(0)), reflect.TypeOf(float64(0)),
+}
+
+func TestNumericKindMatrix(t *testing.T) {
+	for _, sourceType := range numericTypes {
+		source := reflect.ValueOf(42).Convert(sourceType).Interface()
+		for _, destType := range numericTypes {
+			t.Run(sourceType.String()+"To"+destType.String(), func(t *testing.T) {
+				dest := reflect.New(destType)
+				assert.NoError(t, SmartMirror(&source, dest.Interface()))
+				assert.Equal(t, reflect.ValueOf(42).Convert(destType).Interface(), dest.Elem().Interface())
+			})
+		}
+		t.Run(sourceType.String()+"ToString", func(t *testing.T) {
+			dest := ""
+			assert.NoError(t, SmartMirror(&source, &dest))
+			assert.Equal(t, "42", dest)
+		})
+		t.Run(sourceType.String()+"ToBool", func(t *testing.T) {
+			dest := false
+			assert.NoError(t, SmartMirror(&source, &dest))
+			assert.True(t, dest)
+		})
+	}
+	for _, destType := range numericTypes {
+		t.Run("StringTo"+destType.String(), func(t *testing.T) {
+			source := "42"
+			dest := reflect.New(destType)
+			assert.NoError(t, SmartMirror(&source, dest.Interface()))
+			assert.Equal(t, reflect.ValueOf(42).Convert(destType).Interface(), dest.Elem().Interface())
+		})
+	}
+}
+
+func TestNumericKindStruct(t *testing.T) {
+	type Sized struct {
+		Int8    int8
+		Int16   int16
+		Int32   int32
+		Int64   int64
+		Uint8   uint8
+		Uint16  uint16
+		Uint32  uint32
+		Uintptr uintptr
+		Float32 float32
+	}
+	type Wide struct {
+		Int8    int64
+		Int16   string
+		Int32   float64
+		Int64   uint8
+		Uint8   int
+		Uint16  float32
+		Uint32  uintptr
+		Uintptr uint16
+		Float32 int32
+	}
+	source := Sized{-8, 16, 32, 64, 8, 16, 32, 7, 32}
+	dest := Wide{}
+	assert.NoError(t, SmartMirror(&source, &dest))
+	assert.Equal(t, Wide{-8, "16", 32, 64, 8, 16, 32, 7, 32}, dest)
+
+	result := Sized{}
+	assert.NoError(t, SmartMirror(&dest, &result))
+	assert.Equal(t, source, result)
+}
+
+func TestNumberString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   string
+		dest     interface{}
+		expected interface{}
+		err      error
+	}{
+		{"Space", " 42 ", new(int), 42, nil},
+		{"Plus", "+7", new(int), 7, nil},
+		{"PlusUint", "+7", new(uint), uint(7), nil},
+		{"Hex", "0x1F", new(int), 31, nil},
+		{"NegativeHex", "-0X1f", new(int64), int64(-31), nil},
+		{"Octal", "0o17", new(uint8), uint8(15), nil},
+		{"Binary", "0b101", new(int), 5, nil},
+		{"LeadingZero", "010", new(int), 10, nil},
+		{"Underscore", "1_000", new(int), 1000, nil},
+		{"HexUnderscore", "0xFF_FF", new(uint16), uint16(65535), nil},
+		{"IntegralFloat", "1.0", new(int), 1, nil},
+		{"Exponent", "1e3", new(uint), uint(1000), nil},
+		{"NegativeExponent", "-2.5e1", new(int8), int8(-25), nil},
+		{"FloatHex", "0x10", new(float64), float64(16), nil},
+		{"FloatUnderscore", " 1_000.5 ", new(float32), float32(1000.5), nil},
+		{"Fractional", "1.5", new(int), nil, ErrParse},
+		{"NaN", "NaN", new(int), nil, ErrParse},
+		{"ExponentOverflow", "1e400", new(int), nil, ErrOverflow},
+		{"FloatOverflow", "1e3", new(int8), nil, ErrOverflow},
+		{"HexOverflow", "0x100", new(uint8), nil, ErrOverflow},
+		{"NegativeUint", "-1", new(uint), nil, ErrOverflow},
+		{"SignAfterPrefix", "0x-1", new(int), nil, ErrParse},
+		{"Empty", "", new(int), nil, ErrParse},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := SmartMirror(&testCase.source, testCase.dest)
+			if testCase.err != nil {
+				assert.True(t, errors.Is(err, testCase.err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, reflect.ValueOf(testCase.dest).Elem().Interface())
+		})
+	}
+}
+
+func TestNumberUnderscore(t *testing.T) {
+	valid := []struct {
+		source   string
+		expected int
+	}{
+		{"1_000", 1000},
+		{"-1_0", -10},
+		{"+1_0", 10},
+		{"0x1_F", 31},
+		{"0b1_0", 2},
+		{"1_0.0", 10},
+		{"1e1_0", 1e10},
+	}
+	for _, testCase := range valid {
+		t.Run(testCase.source, func(t *testing.T) {
+			dest := 0
+			assert.NoError(t, SmartMirror(&testCase.source, &dest))
+			assert.Equal(t, testCase.expected, dest)
+		})
+	}
+
+	invalid := []string{"_1", "1_", "1__0", "_1__0_", "-_1", "+_1", "0x_1", "0b_1", "0_x1", "1_.0", "1._0", "1_e3", "1e_3"}
+	for _, source := range invalid {
+		t.Run(source, func(t *testing.T) {
+			dest := 0
+			assert.True(t, errors.Is(SmartMirror(&source, &dest), ErrParse))
+			float := 0.0
+			assert.True(t, errors.Is(SmartMirror(&source, &float), ErrParse))
+		})
+	}
+
+	t.Run("Float", func(t *testing.T) {
+		source := "1_000.000_5"
+		dest := 0.0
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, 1000.0005, dest)
+	})
+}
+
+func TestNumberSyntax(t *testing.T) {
+	testCases := []struct {
+		source string
+		syntax NumberSyntax
+	}{
+		{" 42", NumberSyntaxAll &^ NumberTrimSpace},
+		{"0x2A", NumberSyntaxAll &^ NumberBasePrefix},
+		{"4_2", NumberSyntaxAll &^ NumberUnderscore},
+		{"42.0", NumberSyntaxAll &^ NumberFloatString},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.source, func(t *testing.T) {
+			dest := 0
+			assert.NoError(t, SmartMirror(&testCase.source, &dest))
+			assert.Equal(t, 42, dest)
+			err := SmartMirror(&testCase.source, &dest, WithNumberSyntax(testCase.syntax))
+			assert.True(t, errors.Is(err, ErrParse))
+			err = SmartMirror(&testCase.source, &dest, WithNumberSyntax(0))
+			assert.True(t, errors.Is(err, ErrParse))
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package mirror
+
+import (
+	"reflect"
+	"time"
+)
+
+//Option customize how mirroring is performed
+type Option func(*_Config)
+
+type _Config struct {
+	tagKeys      []string
+	nameStrategy NameStrategy
+	bestEffort   bool
+
+	caseInsensitiveKeys bool
+	collectErrors       bool
+	deepCopy            bool
+	reuseContainers     bool
+	reusePointers       bool
+	slicePolicy         SlicePolicy
+	sliceMergeKey       string
+	arrayPolicy         ArrayPolicy
+	wrapNumbers         bool
+	rounding            RoundingPolicy
+	numberSyntax        NumberSyntax
+	boolValues          map[string]bool
+	emptyBool           EmptyBoolPolicy
+	stringFormat        _StringFormat
+	bytesEncoding       BytesEncoding
+	timeLayouts         []string
+	timeLocation        *time.Location
+	timeUnit            time.Duration
+
+	maxDepth    int
+	maxElements int
+	maxNodes    int
+
+	registry    *ConverterRegistry
+	ownRegistry bool
+
+	//Compiled plan, reset by option that change how field is mapped
+	plans *_PlanCache
+	//Compiled plan of config created per call with different naming, shared with derived config
+	derivedPlans *_DerivedPlanCache
+}
+
+func _NewConfig(options ...Option) *_Config {
+	config := &_Config{
+		tagKeys:         []string{"mirror", "json", "mapstructure", "db"},
+		nameStrategy:    ExactCase,
+		reuseContainers: true,
+		slicePolicy:     SliceReplace,
+		numberSyntax:    NumberSyntaxAll,
+		boolValues:      _DefaultBoolValues,
+		stringFormat:    _DefaultStringFormat,
+		bytesEncoding:   BytesRaw,
+		timeLayouts:     _DefaultTimeLayouts,
+		timeUnit:        time.Second,
+		registry:        NewConverterRegistry(),
+		ownRegistry:     true,
+	}
+	for _, option := range options {
+		option(config)
+	}
+	config.plans = _NewPlanCache()
+	config.derivedPlans = _NewDerivedPlanCache()
+	return config
+}
+
+//Create config for a single call, the config itself is returned if no option is given
+func (c *_Config) With(options []Option) *_Config {
+	if len(options) == 0 {
+		return c
+	}
+	config := *c
+	config.ownRegistry = false
+	for _, option := range options {
+		option(&config)
+	}
+	if config.plans == nil {
+		config.plans = c.derivedPlans.Get(&config)
+	}
+	return &config
+}
+
+//Set struct tag lookup order, first tag present in a field will be used
+//Default is mirror, json, mapstructure then db
+func WithTagKeys(keys ...string) Option {
+	return func(config *_Config) {
+		config.tagKeys = append([]string(nil), keys...)
+		config.plans = nil
+	}
+}
+
+//Set naming strategy used to generate key from field name, see [NameStrategy]
+func WithNameStrategy(strategy NameStrategy) Option {
+	return func(config *_Config) {
+		if strategy == nil {
+			strategy = ExactCase
+		}
+		config.nameStrategy = strategy
+		config.plans = nil
+	}
+}
+
+//Match map key to struct field without considering letter case
+//Exact match is always preferred, it only applies when mirroring map to struct
+func WithCaseInsensitiveKeys() Option {
+	return func(config *_Config) {
+		config.caseInsensitiveKeys = true
+		config.plans = nil
+	}
+}
+
+//Keep mirroring after a failure and return every failure as [MirrorErrors]
+//When used with SmartMirror, value that is discarded will also be reported
+func WithCollectErrors() Option {
+	return func(config *_Config) {
+		config.collectErrors = true
+	}
+}
+
+//Make Mirror attempt to convert data type like SmartMirror
+func WithBestEffort() Option {
+	return func(config *_Config) {
+		config.bestEffort = true
+	}
+}
+
+//Use converter registry instead of the instance registry
+//Registry is shared, converter registered later will also be used
+func WithConverterRegistry(registry *ConverterRegistry) Option {
+	return func(config *_Config) {
+		config.registry = registry
+		config.ownRegistry = false
+	}
+}
+
+//Register converter from source type to destination type, see [ConverterRegistry]
+//When used for a single call, the converter will only be used by that call
+func WithConverter(sourceType, destType reflect.Type, converter ConverterFunc) Option {
+	return func(config *_Config) {
+		if !config.ownRegistry {
+			config.registry = config.registry.Clone()
+			config.ownRegistry = true
+		}
+		config.registry.Register(sourceType, destType, converter)
+	}
+}
+
+//Duplicate every slice, map, pointer and interface instead of sharing it with the source
+func WithDeepCopy() Option {
+	return func(config *_Config) {
+		config.deepCopy = true
+	}
+}
+
+//Abort with [ErrLimitExceeded] if source is nested deeper than depth, 0 means unlimited
+//Depth is counted by struct field, map entry and list element from the root
+func WithMaxDepth(depth int) Option {
+	return func(config *_Config) {
+		config.maxDepth = depth
+	}
+}
+
+//Abort with [ErrLimitExceeded] if a slice, array or map in source has more than count element, 0 means unlimited
+func WithMaxElements(count int) Option {
+	return func(config *_Config) {
+		config.maxElements = count
+	}
+}
+
+//Abort with [ErrLimitExceeded] if more than count value is visited in total, 0 means unlimited
+func WithMaxNodes(count int) Option {
+	return func(config *_Config) {
+		config.maxNodes = count
+	}
+}
+
+//Set whether non nil map and slice in destination is mirrored into
+//If false, new container will always be allocated and replace the existing one
+//Nil container is always allocated as needed
+func WithReuseContainers(reuse bool) Option {
+	return func(config *_Config) {
+		config.reuseContainers = reuse
+	}
+}
+
+//Set whether non nil pointer in destination is mirrored into, false by default
+//Pointer may be shared outside of the destination, so new value is allocated unless it's enabled
+func WithReusePointers(reuse bool) Option {
+	return func(config *_Config) {
+		config.reusePointers = reuse
+	}
+}
+
+//Set how source slice is mirrored into non empty destination slice, see [SlicePolicy]
+//Can be overridden per field using `slice=replace|append|merge` tag option
+func WithSlicePolicy(policy SlicePolicy) Option {
+	return func(config *_Config) {
+		config.slicePolicy = policy
+	}
+}
+
+//Merge slice element by key field, key is the mirror name of a struct field or a map key
+//Can be overridden per field using `key=ID` tag option
+func WithSliceMergeKey(key string) Option {
+	return func(config *_Config) {
+		config.slicePolicy = SliceMerge
+		config.sliceMergeKey = key
+	}
+}
+
+//Set what happen when source length differ from destination array length, see [ArrayPolicy]
+//Default is [ArrayStrict]
+func WithArrayPolicy(policy ArrayPolicy) Option {
+	return func(config *_Config) {
+		config.arrayPolicy = policy
+	}
+}
+
+//Convert number using plain Go conversion instead of returning [ErrOverflow]
+//Number that doesn't fit in destination type will wrap around, and integer that can't be represented exactly by float is rounded
+func WithNumberWrapping() Option {
+	return func(config *_Config) {
+		config.wrapNumbers = true
+	}
+}
+
+//Set how float with fractional part is converted into integer, see [RoundingPolicy]
+//Can be overridden per field using `round=truncate|even|away|floor|ceil|reject` tag option
+func WithRounding(policy RoundingPolicy) Option {
+	return func(config *_Config) {
+		config.rounding = policy
+	}
+}
+
+//Set extended syntax accepted when parsing string into number, see [NumberSyntax]
+//Default is [NumberSyntaxAll], use 0 to only accept plain base 10 number
+func WithNumberSyntax(syntax NumberSyntax) Option {
+	return func(config *_Config) {
+		config.numberSyntax = syntax
+	}
+}
+
+//Set string accepted as true and false when mirroring string into bool
+//Value is matched without considering letter case and surrounding whitespace
+//Default is 1, t, true, y, yes, on, enable, enabled and their false counterpart
+func WithBoolValues(truthy, falsy []string) Option {
+	return func(config *_Config) {
+		config.boolValues = _NewBoolValues(truthy, falsy)
+	}
+}
+
+//Set what happen when empty string is mirrored into bool, see [EmptyBoolPolicy]
+func WithEmptyBool(policy EmptyBoolPolicy) Option {
+	return func(config *_Config) {
+		config.emptyBool = policy
+	}
+}
+
+//Set format verb and precision used when float is mirrored into string, see strconv.FormatFloat
+//Default is 'g' with -1 precision, can be overridden per field using `format=f` and `precision=2` tag option
+func WithFloatFormat(format byte, precision int) Option {
+	return func(config *_Config) {
+		config.stringFormat.SetFloatFormat(string(format))
+		config.stringFormat.floatPrecision = precision
+	}
+}
+
+//Set base used when integer is mirrored into string, must be between 2 and 36
+//Default is 10, can be overridden per field using `base=16` tag option
+func WithIntBase(base int) Option {
+	return func(config *_Config) {
+		config.stringFormat.SetIntBase(base)
+	}
+}
+
+//Set string used when bool is mirrored into string
+//Default is true and false, can be overridden per field using `bool=yes|no` tag option
+func WithBoolFormat(truthy, falsy string) Option {
+	return func(config *_Config) {
+		config.stringFormat.boolTrue = truthy
+		config.stringFormat.boolFalse = falsy
+	}
+}
+
+//Set how byte slice is encoded when it's mirrored into string and decoded back, see [BytesEncoding]
+//Default is [BytesRaw], can be overridden per field using `encoding=raw|base64|base64url|hex` tag option
+func WithBytesEncoding(encoding BytesEncoding) Option {
+	return func(config *_Config) {
+		config.bytesEncoding = encoding
+	}
+}
+
+//Set layout used to parse string into time.Time, the first layout is also used to format time into string
+//Default is RFC3339 followed by date time and date only layout, can be overridden per field using `layout=2006-01-02` tag option
+func WithTimeLayouts(layouts ...string) Option {
+	return func(config *_Config) {
+		if len(layouts) > 0 {
+			config.timeLayouts = append([]string(nil), layouts...)
+		}
+	}
+}
+
+//Set location time is converted into when it's parsed or formatted, default is UTC
+//String without time zone is also parsed in this location
+func WithTimeLocation(location *time.Location) Option {
+	return func(config *_Config) {
+		config.timeLocation = location
+	}
+}
+
+//Set unit of Unix time number mirrored from and into time.Time, ex : time.Millisecond
+//Unit must divide or be a multiple of a second, otherwise it's ignored
+//Default is time.Second, can be overridden per field using `unit=ns|us|ms|s|m|h` tag option
+//Duration always use nanosecond unless it's overridden by tag
+func WithTimeUnit(unit time.Duration) Option {
+	return func(config *_Config) {
+		if unit > 0 && (unit%time.Second == 0 || time.Second%unit == 0) {
+			config.timeUnit = unit
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package mirror
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+//NumberSyntax select extended syntax accepted by SmartMirror when parsing string into number
+//Flag can be combined, plain base 10 number is always accepted
+type NumberSyntax int
+
+const (
+	//Ignore leading and trailing whitespace, ex : " 42 "
+	NumberTrimSpace NumberSyntax = 1 << iota
+	//Accept 0x, 0o and 0b prefix, ex : "0x1F"
+	NumberBasePrefix
+	//Accept underscore between digit like Go number literal, ex : "1_000" but not "_1", "1__0" or "0x_1"
+	NumberUnderscore
+	//Accept decimal point and exponent for integer destination as long as it's integral, ex : "1.0" or "1e3"
+	NumberFloatString
+
+	//Every extended syntax, used by default
+	NumberSyntaxAll = NumberTrimSpace | NumberBasePrefix | NumberUnderscore | NumberFloatString
+)
+
+//Normalize number string according to syntax
+//Return the number without base prefix and it's base
+func _NormalizeNumber(raw string, syntax NumberSyntax) (string, int) {
+	if syntax&NumberTrimSpace != 0 {
+		raw = strings.TrimSpace(raw)
+	}
+	sign, digits := "", raw
+	if len(digits) > 0 && (digits[0] == '+' || digits[0] == '-') {
+		sign, digits = digits[:1], digits[1:]
+	}
+	base := 10
+	if syntax&NumberBasePrefix != 0 && len(digits) >= 3 && digits[0] == '0' && digits[2] != '+' && digits[2] != '-' {
+		switch digits[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+		if base != 10 {
+			digits = digits[2:]
+		}
+	}
+	//Misplaced underscore is kept so parsing fail
+	if syntax&NumberUnderscore != 0 && strings.Contains(digits, "_") && _IsUnderscoreValid(digits, base) {
+		digits = strings.ReplaceAll(digits, "_", "")
+	}
+	return sign + digits, base
+}
+
+//Report whether every underscore is placed between two digit like Go number literal
+//Leading, trailing and repeated underscore, or underscore next to sign, base prefix, decimal point or exponent is invalid
+func _IsUnderscoreValid(digits string, base int) bool {
+	for i := 0; i < len(digits); i++ {
+		if digits[i] == '_' && (i == 0 || i == len(digits)-1 || !_IsDigit(digits[i-1], base) || !_IsDigit(digits[i+1], base)) {
+			return false
+		}
+	}
+	return true
+}
+
+func _IsDigit(char byte, base int) bool {
+	switch {
+	case char >= '0' && char <= '9':
+		return true
+	case base == 16:
+		return (char >= 'a' && char <= 'f') || (char >= 'A' && char <= 'F')
+	}
+	return false
+}
+
+func _ParseIntString(raw string, syntax NumberSyntax) (int64, error) {
+	number, base := _NormalizeNumber(raw, syntax)
+	return strconv.ParseInt(number, base, 64)
+}
+
+func _ParseUintString(raw string, syntax NumberSyntax) (uint64, error) {
+	number, base := _NormalizeNumber(raw, syntax)
+	if syntax != 0 && strings.HasPrefix(number, "+") {
+		number = number[1:]
+	}
+	return strconv.ParseUint(number, base, 64)
+}
+
+//Parse string that failed to be parsed as integer as float, see [NumberFloatString]
+//Return false if it's not allowed or the string is not an integral float
+//Float that is out of range is returned as infinity so it's reported as overflow
+func _ParseIntegerFloatString(raw string, err error, syntax NumberSyntax) (float64, bool) {
+	if syntax&NumberFloatString == 0 || !errors.Is(err, strconv.ErrSyntax) {
+		return 0, false
+	}
+	number, _ := _NormalizeNumber(raw, syntax&^NumberBasePrefix)
+	float, err := _ParseFloat(number)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, false
+	}
+	return float, float == math.Trunc(float)
+}
+
+func _ParseFloatString(raw string, syntax NumberSyntax) (float64, error) {
+	number, base := _NormalizeNumber(raw, syntax)
+	if base != 10 {
+		integer, err := strconv.ParseInt(number, base, 64)
+		return float64(integer), err
+	}
+	return _ParseFloat(number)
+}
+
+//Parse base 10 float, unlike strconv.ParseFloat underscore is never accepted
+//Underscore is already removed if it's allowed, see [NumberUnderscore]
+func _ParseFloat(number string) (float64, error) {
+	if strings.Contains(number, "_") {
+		return 0, &strconv.NumError{Func: "ParseFloat", Num: number, Err: strconv.ErrSyntax}
+	}
+	return strconv.ParseFloat(number, 64)
+}
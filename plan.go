@@ -0,0 +1,246 @@
+package mirror
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+//Precomputed field mapping between a pair of type
+//Compiled once per type pair and reused for every following call
+type _StructPlan struct {
+	fields []_FieldPlan
+	//Source map key must be indexed before lookup, see [_MapKeyIndex]
+	useKeyIndex bool
+}
+
+type _FieldPlan struct {
+	_StructField
+	//Go field name, used to build error path
+	fieldName string
+	//Tag used to override call option, nil if no option is set
+	options *_FieldTag
+	//Index sequence and option of matching source field when mirroring struct to struct
+	sourcePath      []int
+	sourceOmitEmpty bool
+	//Source and destination field has the same non pointer type
+	sameType bool
+	//Field must be mirrored instead of set directly when deep copying
+	hasReference bool
+	//Field may need to be mirrored instead of set directly, see [_MirrorContext.MustMirror]
+	hasSlice bool
+	//Map key already converted to map key type, invalid if it must be mirrored on each call
+	key reflect.Value
+}
+
+//Concurrent cache of compiled plan keyed by source and destination type
+type _PlanCache struct {
+	plans sync.Map
+	//Whether any field of struct type override slice policy using tag
+	sliceOptions sync.Map
+	//Index path of slice merge key field keyed by struct type and key
+	keyPaths sync.Map
+}
+
+type _KeyPathPair struct {
+	structType reflect.Type
+	key        string
+}
+
+func _NewPlanCache() *_PlanCache {
+	return &_PlanCache{}
+}
+
+//Identify how field is named, config with the same naming can share compiled plan
+type _NamingKey struct {
+	tagKeys             string
+	nameStrategy        uintptr
+	caseInsensitiveKeys bool
+}
+
+//Plan cache of config created per call with different naming option, see [_Config.With]
+//Only a handful of naming is expected so the number of cache is bounded
+type _DerivedPlanCache struct {
+	mutex  sync.Mutex
+	caches map[_NamingKey]*_PlanCache
+}
+
+const _DerivedPlanCacheSize = 32
+
+//Builtin naming strategy, custom strategy can't be identified since closure share the same code
+var _BuiltinNameStrategies = map[uintptr]bool{
+	reflect.ValueOf(ExactCase).Pointer():          true,
+	reflect.ValueOf(SnakeCase).Pointer():          true,
+	reflect.ValueOf(ScreamingSnakeCase).Pointer(): true,
+	reflect.ValueOf(KebabCase).Pointer():          true,
+	reflect.ValueOf(CamelCase).Pointer():          true,
+}
+
+func _NewDerivedPlanCache() *_DerivedPlanCache {
+	return &_DerivedPlanCache{
+		caches: make(map[_NamingKey]*_PlanCache),
+	}
+}
+
+//Get plan cache shared by config of the same naming
+//New cache is returned for custom naming strategy or once the bound is reached
+func (d *_DerivedPlanCache) Get(config *_Config) *_PlanCache {
+	strategy := reflect.ValueOf(config.nameStrategy).Pointer()
+	if !_BuiltinNameStrategies[strategy] {
+		return _NewPlanCache()
+	}
+	key := _NamingKey{
+		tagKeys:             strings.Join(config.tagKeys, ","),
+		nameStrategy:        strategy,
+		caseInsensitiveKeys: config.caseInsensitiveKeys,
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if cache, ok := d.caches[key]; ok {
+		return cache
+	}
+	cache := _NewPlanCache()
+	if len(d.caches) < _DerivedPlanCacheSize {
+		d.caches[key] = cache
+	}
+	return cache
+}
+
+//Get plan for type pair, compile will be called if it's not cached yet
+func (c *_PlanCache) Get(sourceType, destType reflect.Type, config *_Config, compile func(sourceType, destType reflect.Type, config *_Config) *_StructPlan) *_StructPlan {
+	pair := _TypePair{sourceType, destType}
+	if plan, ok := c.plans.Load(pair); ok {
+		return plan.(*_StructPlan)
+	}
+	plan, _ := c.plans.LoadOrStore(pair, compile(sourceType, destType, config))
+	return plan.(*_StructPlan)
+}
+
+//Report whether struct type contain slice and any of it's field override slice policy using tag
+func (c *_PlanCache) HasSliceOption(structType reflect.Type, config *_Config) bool {
+	if cached, ok := c.sliceOptions.Load(structType); ok {
+		return cached.(bool)
+	}
+	result := false
+	if !_HasSlice(structType) {
+		c.sliceOptions.Store(structType, result)
+		return result
+	}
+	for _, field := range _GetStructFields(structType, config) {
+		if field.slicePolicy != 0 {
+			result = true
+			break
+		}
+	}
+	c.sliceOptions.Store(structType, result)
+	return result
+}
+
+//Get index path of the field named key in struct type, nil if there is no such field
+func (c *_PlanCache) KeyPath(structType reflect.Type, key string, config *_Config) []int {
+	pair := _KeyPathPair{structType, key}
+	if cached, ok := c.keyPaths.Load(pair); ok {
+		return cached.([]int)
+	}
+	var path []int
+	if field, ok := _GetStructFieldIndex(structType, config, false)[key]; ok {
+		path = field.path
+	}
+	c.keyPaths.Store(pair, path)
+	return path
+}
+
+//Get tag as field option, nil if it doesn't override any option
+func _GetFieldOptions(tag _FieldTag) *_FieldTag {
+	if !tag.HasOption() {
+		return nil
+	}
+	return &tag
+}
+
+//Convert key name into map key type if possible
+func _CompileMapKey(name string, keyType reflect.Type) reflect.Value {
+	key := reflect.ValueOf(name)
+	if keyType.Kind() == reflect.String {
+		return key.Convert(keyType)
+	}
+	if key.Type().AssignableTo(keyType) {
+		return key
+	}
+	return reflect.Value{}
+}
+
+func _CompileStructToStruct(sourceType, destType reflect.Type, config *_Config) *_StructPlan {
+	sourceFields := _GetStructFieldIndex(sourceType, config, false)
+	var sourceGoFields map[string]_StructField
+	destFields := _GetStructFields(destType, config)
+	plan := &_StructPlan{
+		fields: make([]_FieldPlan, 0, len(destFields)),
+	}
+	for _, field := range destFields {
+		destStructField := destType.Field(field.index)
+		sourceField, ok := sourceFields[field.name]
+		if !ok {
+			//Tag name on only one side shouldn't stop field of the same Go name from being matched
+			if sourceGoFields == nil {
+				sourceGoFields = _GetStructFieldIndex(sourceType, config, true)
+			}
+			if sourceField, ok = sourceGoFields[destStructField.Name]; !ok {
+				continue
+			}
+		}
+		options := _GetFieldOptions(field._FieldTag)
+		if options == nil {
+			options = _GetFieldOptions(sourceField._FieldTag)
+		}
+		plan.fields = append(plan.fields, _FieldPlan{
+			_StructField:    field,
+			fieldName:       destStructField.Name,
+			options:         options,
+			sourcePath:      sourceField.path,
+			sourceOmitEmpty: sourceField.omitEmpty,
+			sameType:        sourceType.FieldByIndex(sourceField.path).Type == destStructField.Type && destStructField.Type.Kind() != reflect.Ptr,
+			hasReference:    _HasReference(destStructField.Type),
+			hasSlice:        _HasSlice(destStructField.Type),
+		})
+	}
+	return plan
+}
+
+func _CompileMapToStruct(sourceType, destType reflect.Type, config *_Config) *_StructPlan {
+	destFields := _GetStructFields(destType, config)
+	plan := &_StructPlan{
+		fields:      make([]_FieldPlan, 0, len(destFields)),
+		useKeyIndex: config.caseInsensitiveKeys,
+	}
+	keyType := sourceType.Key()
+	for _, field := range destFields {
+		if len(field.aliases) > 0 {
+			plan.useKeyIndex = true
+		}
+		plan.fields = append(plan.fields, _FieldPlan{
+			_StructField: field,
+			fieldName:    destType.Field(field.index).Name,
+			options:      _GetFieldOptions(field._FieldTag),
+			key:          _CompileMapKey(field.name, keyType),
+		})
+	}
+	return plan
+}
+
+func _CompileStructToMap(sourceType, destType reflect.Type, config *_Config) *_StructPlan {
+	sourceFields := _GetStructFields(sourceType, config)
+	plan := &_StructPlan{
+		fields: make([]_FieldPlan, 0, len(sourceFields)),
+	}
+	keyType := destType.Key()
+	for _, field := range sourceFields {
+		plan.fields = append(plan.fields, _FieldPlan{
+			_StructField: field,
+			fieldName:    sourceType.Field(field.index).Name,
+			options:      _GetFieldOptions(field._FieldTag),
+			key:          _CompileMapKey(field.name, keyType),
+		})
+	}
+	return plan
+}
@@ -0,0 +1,75 @@
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanCache(t *testing.T) {
+	type Person struct {
+		FirstName string
+		Age       int
+	}
+	instance := New()
+	source := Person{FirstName: "Rendoru", Age: 22}
+
+	dest := map[string]interface{}{}
+	assert.NoError(t, instance.Mirror(&source, &dest))
+	plan := instance.config.plans.Get(reflect.TypeOf(source), reflect.TypeOf(dest), instance.config, func(sourceType, destType reflect.Type, config *_Config) *_StructPlan {
+		t.Error("Plan should already be compiled")
+		return nil
+	})
+	if assert.NotNil(t, plan) && assert.Len(t, plan.fields, 2) {
+		assert.Equal(t, "FirstName", plan.fields[0].key.Interface())
+	}
+
+	t.Run("CallOptionNotCached", func(t *testing.T) {
+		dest := map[string]interface{}{}
+		assert.NoError(t, instance.Mirror(&source, &dest, WithNameStrategy(SnakeCase)))
+		assert.Equal(t, map[string]interface{}{"first_name": "Rendoru", "age": 22}, dest)
+
+		dest = map[string]interface{}{}
+		assert.NoError(t, instance.Mirror(&source, &dest))
+		assert.Equal(t, map[string]interface{}{"FirstName": "Rendoru", "Age": 22}, dest)
+	})
+
+	t.Run("CallOptionPlanReused", func(t *testing.T) {
+		options := []Option{WithNameStrategy(SnakeCase), WithTagKeys("json")}
+		first := instance.config.With(options)
+		second := instance.config.With(options)
+		assert.True(t, first.plans == second.plans)
+		assert.False(t, first.plans == instance.config.plans)
+		assert.False(t, first.plans == instance.config.With([]Option{WithNameStrategy(KebabCase)}).plans)
+		assert.False(t, first.plans == instance.config.With([]Option{WithNameStrategy(SnakeCase), WithCaseInsensitiveKeys(), WithTagKeys("json")}).plans)
+
+		custom := []Option{WithNameStrategy(func(name string) string { return name })}
+		assert.False(t, instance.config.With(custom).plans == instance.config.With(custom).plans)
+	})
+
+	t.Run("NamedKeyType", func(t *testing.T) {
+		type Key string
+		dest := map[Key]interface{}{}
+		assert.NoError(t, instance.Mirror(&source, &dest))
+		assert.Equal(t, map[Key]interface{}{"FirstName": "Rendoru", "Age": 22}, dest)
+
+		back := Person{}
+		assert.NoError(t, instance.Mirror(&dest, &back))
+		assert.Equal(t, source, back)
+	})
+}
+
+func TestPlanCacheKeyPath(t *testing.T) {
+	instance := New()
+	source := []SliceItem{{ID: 2, Name: "Ren"}}
+	dest := []SliceItem{{ID: 2, Name: "A", Count: 1}}
+	assert.NoError(t, instance.Mirror(&source, &dest, WithSliceMergeKey("ID")))
+	assert.Equal(t, []SliceItem{{ID: 2, Name: "Ren", Count: 0}}, dest)
+
+	cached, ok := instance.config.plans.keyPaths.Load(_KeyPathPair{reflect.TypeOf(SliceItem{}), "ID"})
+	if assert.True(t, ok) {
+		assert.Equal(t, []int{0}, cached)
+	}
+	assert.Nil(t, instance.config.plans.KeyPath(reflect.TypeOf(SliceItem{}), "Missing", instance.config))
+}
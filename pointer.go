@@ -4,7 +4,7 @@ import (
 	"reflect"
 )
 
-func _HandlePointer(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
+func _HandlePointer(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
 
 	sourceType := source.Type()
 	destType := dest.Type()
@@ -15,7 +15,21 @@ func _HandlePointer(source, dest reflect.Value, sourceKind, destKind reflect.Kin
 	if sourceKind == reflect.Ptr {
 		source = source.Elem()
 	}
-	newDest := reflect.New(destType.Elem())
-	dest.Set(newDest)
-	return _RecursiveMirror(source, dest.Elem(), bestEffort)
+	//Reuse pointer created for the same source so cycle terminate
+	//Pointer that is reused keep it's own value so it's never visited
+	fresh := dest.IsNil() || !ctx.config.reusePointers
+	key, trackable := _NewVisitKey(source, destType)
+	if fresh && trackable {
+		if visited, ok := ctx.Visited(key); ok {
+			dest.Set(visited)
+			return nil
+		}
+	}
+	if fresh {
+		dest.Set(reflect.New(destType.Elem()))
+		if trackable {
+			ctx.Visit(key, dest)
+		}
+	}
+	return _RecursiveMirror(source, dest.Elem(), ctx)
 }
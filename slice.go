@@ -0,0 +1,203 @@
+package mirror
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+//SlicePolicy decide how source slice is mirrored into non empty destination slice
+type SlicePolicy int
+
+const (
+	//Destination slice is replaced with mirrored source element
+	SliceReplace SlicePolicy = iota + 1
+	//Mirrored source element is appended to destination slice
+	SliceAppend
+	//Element is matched by key field and mirrored into matching destination element
+	//Element without match is appended, see [WithSliceMergeKey]
+	SliceMerge
+)
+
+func _ParseSlicePolicy(raw string) SlicePolicy {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "replace":
+		return SliceReplace
+	case "append":
+		return SliceAppend
+	case "merge":
+		return SliceMerge
+	}
+	return 0
+}
+
+var _SliceTypeCache sync.Map
+
+//Report whether type is or contain slice without following pointer
+func _HasSlice(valueType reflect.Type) bool {
+	if cached, ok := _SliceTypeCache.Load(valueType); ok {
+		return cached.(bool)
+	}
+	result := false
+	switch valueType.Kind() {
+	case reflect.Slice:
+		result = true
+	case reflect.Struct:
+		for i := 0; i < valueType.NumField(); i++ {
+			field := valueType.Field(i)
+			if field.PkgPath == "" && _HasSlice(field.Type) {
+				result = true
+				break
+			}
+		}
+	}
+	_SliceTypeCache.Store(valueType, result)
+	return result
+}
+
+//Get slice policy and merge key, field tag take precedence over call option
+//Zero policy is kept as is, see [_MirrorContext.SlicePolicy]
+func (ctx *_MirrorContext) _GetSlicePolicy(tag *_FieldTag) (SlicePolicy, string) {
+	policy := ctx.config.slicePolicy
+	key := ctx.config.sliceMergeKey
+	if tag != nil && tag.slicePolicy != 0 {
+		policy = tag.slicePolicy
+		if tag.mergeKey != "" {
+			key = tag.mergeKey
+		}
+	}
+	if policy == SliceMerge && key == "" {
+		policy = SliceAppend
+	}
+	return policy, key
+}
+
+//Get slice policy and merge key for the current value
+//Zero policy used by the default instance append element like previous version
+func (ctx *_MirrorContext) SlicePolicy() (SlicePolicy, string) {
+	policy, key := ctx._GetSlicePolicy(ctx.FieldTag())
+	if policy == 0 {
+		policy = SliceAppend
+	}
+	return policy, key
+}
+
+//Report whether dest must be mirrored into instead of assigned even if source has the same type
+//It's needed so slice policy other than replace is honored
+func (ctx *_MirrorContext) MustMirror(dest reflect.Value, tag *_FieldTag) bool {
+	if !ctx.config.reuseContainers {
+		return false
+	}
+	switch dest.Kind() {
+	case reflect.Slice:
+		if dest.Len() == 0 {
+			return false
+		}
+		policy, _ := ctx._GetSlicePolicy(tag)
+		return policy != 0 && policy != SliceReplace
+	case reflect.Struct:
+		if policy := ctx.config.slicePolicy; policy != 0 && policy != SliceReplace {
+			return _HasSlice(dest.Type())
+		}
+		return ctx.config.plans.HasSliceOption(dest.Type(), ctx.config)
+	}
+	return false
+}
+
+//Get value of key field from a struct or map element
+func _GetElementKey(element reflect.Value, key string, config *_Config) reflect.Value {
+	for element.Kind() == reflect.Ptr || element.Kind() == reflect.Interface {
+		if element.IsNil() {
+			return reflect.Value{}
+		}
+		element = element.Elem()
+	}
+	switch element.Kind() {
+	case reflect.Struct:
+		path := config.plans.KeyPath(element.Type(), key, config)
+		if path == nil {
+			return reflect.Value{}
+		}
+		value, _ := _FieldByPath(element, path)
+		return value
+	case reflect.Map:
+		mapKey := _CompileMapKey(key, element.Type().Key())
+		if !mapKey.IsValid() {
+			return reflect.Value{}
+		}
+		return element.MapIndex(mapKey)
+	}
+	return reflect.Value{}
+}
+
+//Get comparable representation of element key, source key is converted to destination key type
+func _GetComparableKey(key reflect.Value, keyType reflect.Type, ctx *_MirrorContext) (interface{}, bool) {
+	for key.Kind() == reflect.Interface && !key.IsNil() {
+		key = key.Elem()
+	}
+	if !key.IsValid() {
+		return nil, false
+	}
+	if keyType != nil && key.Type() != keyType {
+		converted := reflect.New(keyType).Elem()
+		if err := _RecursiveMirror(key, converted, ctx); err != nil {
+			return nil, false
+		}
+		key = converted
+	}
+	if !key.Type().Comparable() {
+		return nil, false
+	}
+	return key.Interface(), true
+}
+
+//Mirror source element into destination element with the same key
+func _MergeList(source, dest reflect.Value, key string, ctx *_MirrorContext) error {
+	destType := dest.Type()
+	destValue := destType.Elem()
+	var keyType reflect.Type
+	indexes := make(map[interface{}]int, dest.Len())
+	for i := 0; i < dest.Len(); i++ {
+		destKey := _GetElementKey(dest.Index(i), key, ctx.config)
+		if keyType == nil && destKey.IsValid() && destKey.Kind() != reflect.Interface {
+			keyType = destKey.Type()
+		}
+		if comparable, ok := _GetComparableKey(destKey, nil, ctx); ok {
+			indexes[comparable] = i
+		}
+	}
+	for i := 0; i < source.Len(); i++ {
+		element := source.Index(i)
+		ctx.PushIndex(i)
+		index := -1
+		if comparable, ok := _GetComparableKey(_GetElementKey(element, key, ctx.config), keyType, ctx); ok {
+			if found, ok := indexes[comparable]; ok {
+				index = found
+			} else {
+				indexes[comparable] = dest.Len()
+			}
+		}
+		var err error
+		if index >= 0 {
+			//Matched pointer element is merged into even if pointer isn't reused
+			target := dest.Index(index)
+			if target.Kind() == reflect.Ptr && !target.IsNil() {
+				target = target.Elem()
+			}
+			err = _RecursiveMirror(element, target, ctx)
+		} else {
+			value := reflect.New(destValue).Elem()
+			if err = _RecursiveMirror(element, value, ctx); err == nil {
+				dest.Set(reflect.Append(dest, value))
+			}
+		}
+		ctx.Pop()
+		if err != nil {
+			if ctx.Skip(err) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
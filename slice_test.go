@@ -0,0 +1,123 @@
+package mirror
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type SliceItem struct {
+	ID    int
+	Name  string
+	Count int
+}
+
+type SliceTagStruct struct {
+	Replaced []string    `mirror:",slice=replace"`
+	Appended []string    `mirror:",slice=append"`
+	Merged   []SliceItem `mirror:",key=ID"`
+}
+
+func TestSlicePolicy(t *testing.T) {
+	source := []string{"C"}
+
+	t.Run("Default", func(t *testing.T) {
+		dest := []string{"A", "B"}
+		assert.NoError(t, Mirror(&source, &dest))
+		assert.Equal(t, []string{"C"}, dest)
+		elements := []interface{}{"C"}
+		dest = []string{"A", "B"}
+		assert.NoError(t, Mirror(&elements, &dest))
+		assert.Equal(t, []string{"A", "B", "C"}, dest)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		dest := []string{"A", "B"}
+		assert.NoError(t, Mirror(&source, &dest, WithSlicePolicy(SliceReplace)))
+		assert.Equal(t, []string{"C"}, dest)
+		assert.NoError(t, New().Mirror(&source, &dest))
+		assert.Equal(t, []string{"C"}, dest)
+	})
+
+	t.Run("Append", func(t *testing.T) {
+		dest := []string{"A", "B"}
+		assert.NoError(t, New(WithSlicePolicy(SliceAppend)).Mirror(&source, &dest))
+		assert.Equal(t, []string{"A", "B", "C"}, dest)
+	})
+
+	t.Run("Twice", func(t *testing.T) {
+		source := map[string]interface{}{"Tags": []interface{}{"A", "B"}}
+		dest := ContainerStruct{}
+		instance := New()
+		assert.NoError(t, instance.SmartMirror(&source, &dest))
+		assert.NoError(t, instance.SmartMirror(&source, &dest))
+		assert.Equal(t, []string{"A", "B"}, dest.Tags)
+	})
+
+	t.Run("MergeWithoutKey", func(t *testing.T) {
+		dest := []string{"A", "B"}
+		assert.NoError(t, Mirror(&source, &dest, WithSlicePolicy(SliceMerge)))
+		assert.Equal(t, []string{"A", "B", "C"}, dest)
+	})
+}
+
+func TestSliceMerge(t *testing.T) {
+	t.Run("Struct", func(t *testing.T) {
+		source := []SliceItem{{ID: 2, Name: "Ren"}, {ID: 3, Name: "Doru"}}
+		dest := []SliceItem{{ID: 1, Name: "A", Count: 1}, {ID: 2, Name: "B", Count: 2}}
+		assert.NoError(t, Mirror(&source, &dest, WithSliceMergeKey("ID")))
+		assert.Equal(t, []SliceItem{
+			{ID: 1, Name: "A", Count: 1},
+			{ID: 2, Name: "Ren", Count: 0},
+			{ID: 3, Name: "Doru"},
+		}, dest)
+	})
+
+	t.Run("MapToPointer", func(t *testing.T) {
+		first := &SliceItem{ID: 1, Name: "A", Count: 1}
+		source := []interface{}{
+			map[string]interface{}{"ID": "1", "Name": "Ren"},
+			map[string]interface{}{"ID": 2, "Name": "Doru"},
+			map[string]interface{}{"ID": 2, "Count": 5},
+		}
+		dest := []*SliceItem{first}
+		assert.NoError(t, SmartMirror(&source, &dest, WithSliceMergeKey("ID")))
+		assert.Len(t, dest, 2)
+		assert.True(t, first == dest[0])
+		assert.Equal(t, SliceItem{ID: 1, Name: "Ren", Count: 1}, *dest[0])
+		assert.Equal(t, SliceItem{ID: 2, Name: "Doru", Count: 5}, *dest[1])
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		source := []map[string]interface{}{{"id": 1, "name": "Ren"}}
+		dest := []map[string]string{{"id": "1", "name": "A", "count": "1"}, {"id": "2"}}
+		assert.NoError(t, SmartMirror(&source, &dest, WithSliceMergeKey("id")))
+		assert.Equal(t, []map[string]string{{"id": "1", "name": "Ren", "count": "1"}, {"id": "2"}}, dest)
+	})
+
+	t.Run("MissingKey", func(t *testing.T) {
+		source := []SliceItem{{ID: 1, Name: "Ren"}}
+		dest := []SliceItem{{ID: 1, Name: "A"}}
+		assert.NoError(t, Mirror(&source, &dest, WithSliceMergeKey("Key")))
+		assert.Equal(t, []SliceItem{{ID: 1, Name: "A"}, {ID: 1, Name: "Ren"}}, dest)
+	})
+}
+
+func TestSlicePolicyTag(t *testing.T) {
+	source := SliceTagStruct{
+		Replaced: []string{"C"},
+		Appended: []string{"C"},
+		Merged:   []SliceItem{{ID: 1, Name: "Ren"}},
+	}
+	dest := SliceTagStruct{
+		Replaced: []string{"A"},
+		Appended: []string{"A"},
+		Merged:   []SliceItem{{ID: 1, Name: "A", Count: 1}, {ID: 2, Name: "B"}},
+	}
+	assert.NoError(t, New().Mirror(&source, &dest))
+	assert.Equal(t, SliceTagStruct{
+		Replaced: []string{"C"},
+		Appended: []string{"A", "C"},
+		Merged:   []SliceItem{{ID: 1, Name: "Ren", Count: 0}, {ID: 2, Name: "B"}},
+	}, dest)
+}
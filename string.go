@@ -1,25 +1,151 @@
 package mirror
 
 import (
-	"errors"
-	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
-func _HandleString(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
-	if !bestEffort {
+//Format used when scalar is mirrored into string
+//Zero value of each field means not set, see [_StringFormat.Merge]
+type _StringFormat struct {
+	floatFormat    byte
+	floatPrecision int
+	precisionSet   bool
+	intBase        int
+	boolTrue       string
+	boolFalse      string
+	boolSet        bool
+}
+
+//Format used by default, same as fmt.Sprint
+var _DefaultStringFormat = _StringFormat{
+	floatFormat:    'g',
+	floatPrecision: -1,
+	precisionSet:   true,
+	intBase:        10,
+	boolTrue:       "true",
+	boolFalse:      "false",
+	boolSet:        true,
+}
+
+//Override format with every field that is set in other
+func (f _StringFormat) Merge(other _StringFormat) _StringFormat {
+	if other.floatFormat != 0 {
+		f.floatFormat = other.floatFormat
+	}
+	if other.precisionSet {
+		f.floatPrecision = other.floatPrecision
+	}
+	if other.intBase != 0 {
+		f.intBase = other.intBase
+	}
+	if other.boolSet {
+		f.boolTrue, f.boolFalse = other.boolTrue, other.boolFalse
+	}
+	return f
+}
+
+//Parse `format=f`, `precision=2`, `base=16` or `bool=yes|no` tag option
+//Return false if option is not a format option, invalid value is ignored
+func (f *_StringFormat) ParseOption(option string) bool {
+	switch {
+	case strings.HasPrefix(option, "format="):
+		f.SetFloatFormat(strings.TrimSpace(option[len("format="):]))
+	case strings.HasPrefix(option, "precision="):
+		if precision, err := strconv.Atoi(strings.TrimSpace(option[len("precision="):])); err == nil {
+			f.floatPrecision, f.precisionSet = precision, true
+		}
+	case strings.HasPrefix(option, "base="):
+		if base, err := strconv.Atoi(strings.TrimSpace(option[len("base="):])); err == nil {
+			f.SetIntBase(base)
+		}
+	case strings.HasPrefix(option, "bool="):
+		if values := strings.Split(option[len("bool="):], "|"); len(values) == 2 {
+			f.boolTrue, f.boolFalse, f.boolSet = values[0], values[1], true
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+//Set float format verb accepted by strconv.FormatFloat, invalid verb is ignored
+func (f *_StringFormat) SetFloatFormat(format string) {
+	if len(format) == 1 && strings.Contains("beEfgGxX", format) {
+		f.floatFormat = format[0]
+	}
+}
+
+//Set integer base accepted by strconv.FormatInt, invalid base is ignored
+func (f *_StringFormat) SetIntBase(base int) {
+	if base >= 2 && base <= 36 {
+		f.intBase = base
+	}
+}
+
+//Format scalar source into string
+func (f _StringFormat) Format(source reflect.Value) string {
+	switch source.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(source.Int(), f.intBase)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(source.Uint(), f.intBase)
+	case reflect.Float32:
+		return strconv.FormatFloat(source.Float(), f.floatFormat, f.floatPrecision, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(source.Float(), f.floatFormat, f.floatPrecision, 64)
+	case reflect.Complex64:
+		return f.FormatComplex(source.Complex(), 32)
+	case reflect.Complex128:
+		return f.FormatComplex(source.Complex(), 64)
+	case reflect.Bool:
+		if source.Bool() {
+			return f.boolTrue
+		}
+		return f.boolFalse
+	}
+	return ""
+}
+
+//Format complex in the same form as fmt such as "(1+2i)", each part is formatted like a float of the given bit size
+func (f _StringFormat) FormatComplex(number complex128, bitSize int) string {
+	imaginary := strconv.FormatFloat(imag(number), f.floatFormat, f.floatPrecision, bitSize)
+	if imaginary[0] != '+' && imaginary[0] != '-' {
+		imaginary = "+" + imaginary
+	}
+	return "(" + strconv.FormatFloat(real(number), f.floatFormat, f.floatPrecision, bitSize) + imaginary + "i)"
+}
+
+//Get string format of the current value, field tag take precedence over call option
+func (ctx *_MirrorContext) StringFormat() _StringFormat {
+	if tag := ctx.InheritedTag(); tag != nil {
+		return ctx.config.stringFormat.Merge(tag.format)
+	}
+	return ctx.config.stringFormat
+}
+
+func _HandleString(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if !ctx.bestEffort {
 		if destKind != sourceKind {
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "String", ErrTypeMismatch)
 		}
 		dest.Set(source)
 	} else {
 		switch sourceKind {
 		case reflect.String:
 			dest.Set(source.Convert(dest.Type()))
-		case reflect.Int, reflect.Uint, reflect.Float32, reflect.Float64, reflect.Bool:
-			dest.SetString(fmt.Sprint(source.Interface()))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
+			dest.SetString(ctx.StringFormat().Format(source))
+		case reflect.Slice, reflect.Array:
+			if !_IsBytes(source.Type()) {
+				return ctx.NewError(source, dest, "String", ErrTypeMismatch)
+			}
+			dest.SetString(_EncodeBytes(source, ctx.BytesEncoding()))
 		default:
-			return errors.New("Destination field type didn't match Source field type")
+			return ctx.NewError(source, dest, "String", ErrTypeMismatch)
 		}
 	}
 	return nil
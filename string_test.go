@@ -1,6 +1,11 @@
 package mirror
 
-import "testing"
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 type MyString string
 
@@ -70,3 +75,72 @@ func TestPrimitiveMyStringToString(t *testing.T) {
 		_PerformTestSmart(val.Name, &val.Source, &val.Destination, &val.Expect, val.HasError, t)
 	}
 }
+
+func TestStringFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   interface{}
+		options  []Option
+		expected string
+	}{
+		{"DefaultFloat", 1e21, nil, "1e+21"},
+		{"FloatFixed", 1e21, []Option{WithFloatFormat('f', -1)}, "1000000000000000000000"},
+		{"FloatPrecision", 3.14159, []Option{WithFloatFormat('f', 2)}, "3.14"},
+		{"FloatZeroPrecision", 2.5, []Option{WithFloatFormat('f', 0)}, "2"},
+		{"Float32", float32(0.1), nil, "0.1"},
+		{"FloatExponent", 1234.5, []Option{WithFloatFormat('e', 3)}, "1.234e+03"},
+		{"InvalidFormat", 1e21, []Option{WithFloatFormat('z', -1)}, "1e+21"},
+		{"IntBase", 255, []Option{WithIntBase(16)}, "ff"},
+		{"NegativeIntBase", int8(-5), []Option{WithIntBase(2)}, "-101"},
+		{"UintBase", uint16(8), []Option{WithIntBase(8)}, "10"},
+		{"InvalidBase", 255, []Option{WithIntBase(1)}, "255"},
+		{"DefaultBool", true, nil, "true"},
+		{"BoolNumber", true, []Option{WithBoolFormat("1", "0")}, "1"},
+		{"BoolWord", false, []Option{WithBoolFormat("yes", "no")}, "no"},
+		{"DefaultComplex", 1 - 2.5i, nil, "(1-2.5i)"},
+		{"Complex64", complex64(0.1 + 0.2i), nil, "(0.1+0.2i)"},
+		{"ComplexPrecision", 1.234 + 5i, []Option{WithFloatFormat('f', 1)}, "(1.2+5.0i)"},
+		{"ComplexInfinity", complex(math.Inf(1), math.Inf(-1)), nil, "(+Inf-Infi)"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := ""
+			assert.NoError(t, SmartMirror(&testCase.source, &dest, testCase.options...))
+			assert.Equal(t, testCase.expected, dest)
+		})
+	}
+}
+
+func TestStringFormatTag(t *testing.T) {
+	type Product struct {
+		Price   float64   `mirror:"price,format=f,precision=2"`
+		Weight  float64   `mirror:"weight,precision=1"`
+		Color   int       `mirror:"color,base=16"`
+		Active  bool      `mirror:"active,bool=yes|no"`
+		Visible bool      `mirror:"visible"`
+		Ratios  []float64 `mirror:"ratios,format=f,precision=1"`
+	}
+	source := Product{
+		Price:   9.5,
+		Weight:  1.25,
+		Color:   0xff00ff,
+		Active:  true,
+		Visible: false,
+		Ratios:  []float64{0.25, 1},
+	}
+	dest := map[string]string{}
+	assert.NoError(t, SmartMirror(&source, &dest, WithFloatFormat('e', -1), WithBoolFormat("1", "0")))
+	assert.Equal(t, map[string]string{
+		"price":   "9.50",
+		"weight":  "1.2e+00",
+		"color":   "ff00ff",
+		"active":  "yes",
+		"visible": "0",
+	}, dest)
+
+	destStruct := struct {
+		Ratios []string `mirror:"ratios"`
+	}{}
+	assert.NoError(t, SmartMirror(&source, &destStruct))
+	assert.Equal(t, []string{"0.2", "1.0"}, destStruct.Ratios)
+}
@@ -1,50 +1,177 @@
 package mirror
 
 import (
-	"errors"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 )
 
 //Handle conversion for struct dest
-func _HandleStruct(source, dest reflect.Value, sourceKind, destKind reflect.Kind, bestEffort bool) error {
+func _HandleStruct(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	//Time only has unexported field, see [_HandleTime]
+	if dest.Type() == _TimeType {
+		return ctx.NewError(source, dest, "Time", ErrTypeMismatch)
+	}
 	if sourceKind == reflect.Struct {
-		return _HandleStructToStruct(source, dest, bestEffort)
+		return _HandleStructToStruct(source, dest, ctx)
 	} else if sourceKind == reflect.Map {
-		return _HandleMapToStruct(source, dest, bestEffort)
+		return _HandleMapToStruct(source, dest, ctx)
 	}
-	return errors.New("Destination field type didn't match Source field type")
+	return ctx.NewError(source, dest, "Struct", ErrTypeMismatch)
 }
 
 //Handle conversion from struct to struct
-func _HandleStructToStruct(source, dest reflect.Value, bestEffort bool) error {
-	destType := dest.Type()
-	numField := destType.NumField()
-	for i := 0; i < numField; i++ {
-		destField := dest.Field(i)
-		destName := destType.Field(i).Name
-		sourceField := source.FieldByName(destName)
-		if sourceField.IsValid() {
-			if !(sourceField.Kind() == reflect.Ptr && sourceField.IsNil()) {
-				if err := _RecursiveMirror(sourceField, destField, bestEffort); err != nil {
-					return err
-				}
+//Field are matched by their mirror name, see [_ParseTag]
+func _HandleStructToStruct(source, dest reflect.Value, ctx *_MirrorContext) error {
+	plan := ctx.config.plans.Get(source.Type(), dest.Type(), ctx.config, _CompileStructToStruct)
+	directSet := !ctx.converters
+	for i := range plan.fields {
+		field := &plan.fields[i]
+		sourceField, ok := _FieldByPath(source, field.sourcePath)
+		if !ok {
+			continue
+		}
+		switch sourceField.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map:
+			if sourceField.IsNil() {
+				continue
+			}
+		}
+		if (field.omitEmpty || field.sourceOmitEmpty) && sourceField.IsZero() {
+			continue
+		}
+		destField := dest.Field(field.index)
+		if field.sameType && directSet && !(ctx.config.deepCopy && field.hasReference) && !(field.hasSlice && ctx.MustMirror(destField, field.options)) {
+			if destField.CanSet() {
+				destField.Set(sourceField)
 			}
+			continue
+		}
+		ctx.PushField(field.fieldName, field.options)
+		err := _RecursiveMirror(sourceField, destField, ctx)
+		ctx.Pop()
+		if err != nil {
+			if ctx.Collect(err) {
+				continue
+			}
+			return err
 		}
 	}
 	return nil
 }
 
 //Handle conversion from Map to struct
-func _HandleMapToStruct(source, dest reflect.Value, bestEffort bool) error {
-	destType := dest.Type()
-	numField := destType.NumField()
-	for i := 0; i < numField; i++ {
-		destField := dest.Field(i)
-		destName := destType.Field(i).Name
-		sourceField := source.MapIndex(reflect.ValueOf(destName))
-		if err := _RecursiveMirror(sourceField, destField, bestEffort); err != nil {
+func _HandleMapToStruct(source, dest reflect.Value, ctx *_MirrorContext) error {
+	plan := ctx.config.plans.Get(source.Type(), dest.Type(), ctx.config, _CompileMapToStruct)
+	var keyIndex *_MapKeyIndex
+	if plan.useKeyIndex {
+		keyIndex = _NewMapKeyIndex(source)
+	}
+	for i := range plan.fields {
+		field := &plan.fields[i]
+		destField := dest.Field(field.index)
+		ctx.PushField(field.fieldName, field.options)
+		var sourceField reflect.Value
+		if keyIndex != nil {
+			key, err := keyIndex.Lookup(field._StructField, ctx.config.caseInsensitiveKeys)
+			if err != nil {
+				err = ctx.NewError(source, destField, "MapToStruct", err)
+				ctx.Pop()
+				if ctx.Collect(err) {
+					continue
+				}
+				return err
+			}
+			if key.IsValid() {
+				sourceField = source.MapIndex(key)
+			}
+		} else if field.key.IsValid() {
+			sourceField = source.MapIndex(field.key)
+		}
+		if field.omitEmpty && !sourceField.IsValid() {
+			ctx.Pop()
+			continue
+		}
+		err := _RecursiveMirror(sourceField, destField, ctx)
+		ctx.Pop()
+		if err != nil {
+			if ctx.Collect(err) {
+				continue
+			}
 			return err
 		}
 	}
 	return nil
 }
+
+//Index of string keys of a map, built once per struct
+type _MapKeyIndex struct {
+	exact  map[string]reflect.Value
+	folded map[string][]string
+}
+
+func _NewMapKeyIndex(source reflect.Value) *_MapKeyIndex {
+	index := &_MapKeyIndex{
+		exact:  make(map[string]reflect.Value, source.Len()),
+		folded: make(map[string][]string, source.Len()),
+	}
+	mapEntry := source.MapRange()
+	for mapEntry.Next() {
+		key := mapEntry.Key()
+		name := key
+		if name.Kind() == reflect.Interface {
+			name = name.Elem()
+		}
+		if name.Kind() != reflect.String {
+			continue
+		}
+		rawName := name.String()
+		index.exact[rawName] = key
+		folded := strings.ToLower(rawName)
+		index.folded[folded] = append(index.folded[folded], rawName)
+	}
+	for _, names := range index.folded {
+		sort.Strings(names)
+	}
+	return index
+}
+
+//Find map key for a struct field
+//Exact name always win, followed by exact alias then case insensitive name or alias
+//Error is returned if more than one key match at the same level
+func (m *_MapKeyIndex) Lookup(field _StructField, caseInsensitive bool) (reflect.Value, error) {
+	if key, ok := m.exact[field.name]; ok {
+		return key, nil
+	}
+	found := make([]string, 0, 1)
+	for _, alias := range field.aliases {
+		if _, ok := m.exact[alias]; ok {
+			found = _AppendUnique(found, alias)
+		}
+	}
+	if len(found) == 0 && caseInsensitive {
+		candidates := append([]string{field.name}, field.aliases...)
+		for _, candidate := range candidates {
+			for _, name := range m.folded[strings.ToLower(candidate)] {
+				found = _AppendUnique(found, name)
+			}
+		}
+	}
+	switch len(found) {
+	case 0:
+		return reflect.Value{}, nil
+	case 1:
+		return m.exact[found[0]], nil
+	}
+	return reflect.Value{}, fmt.Errorf("%w, found %s", ErrAmbiguousKey, strings.Join(found, ", "))
+}
+
+func _AppendUnique(values []string, value string) []string {
+	for _, val := range values {
+		if val == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -1,6 +1,10 @@
 package mirror
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 type PrimitiveStruct struct {
 	Name string
@@ -254,3 +258,186 @@ func TestPrimitiveMapToStuct(t *testing.T) {
 		t.Errorf("Expected %v got %v", source["Name"], destination.Name)
 	}
 }
+
+func TestMapToStructCaseInsensitive(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Source   map[string]interface{}
+		Expect   PrimitiveStruct
+		HasError bool
+	}{
+		{
+			"Lower",
+			map[string]interface{}{"name": "Rendoru", "age": uint(22)},
+			PrimitiveStruct{"Rendoru", 22},
+			false,
+		},
+		{
+			"Upper",
+			map[string]interface{}{"NAME": "Rendoru", "AGE": uint(22)},
+			PrimitiveStruct{"Rendoru", 22},
+			false,
+		},
+		{
+			"ExactWin",
+			map[string]interface{}{"Name": "Rendoru", "NAME": "Doru", "Age": uint(22)},
+			PrimitiveStruct{"Rendoru", 22},
+			false,
+		},
+		{
+			"Ambiguous",
+			map[string]interface{}{"name": "Rendoru", "NAME": "Doru", "Age": uint(22)},
+			PrimitiveStruct{},
+			true,
+		},
+	}
+
+	for _, val := range testData {
+		t.Run(val.Name, func(t *testing.T) {
+			dest := PrimitiveStruct{}
+			err := Mirror(&val.Source, &dest, WithCaseInsensitiveKeys())
+			if val.HasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, val.Expect, dest)
+		})
+	}
+}
+
+func TestMapToStructAlias(t *testing.T) {
+	type Person struct {
+		Name string `mirror:"name,alias=fullName|full_name"`
+	}
+
+	t.Run("Alias", func(t *testing.T) {
+		source := map[string]interface{}{"full_name": "Rendoru"}
+		dest := Person{}
+		assert.NoError(t, Mirror(&source, &dest))
+		assert.Equal(t, "Rendoru", dest.Name)
+	})
+
+	t.Run("NameWin", func(t *testing.T) {
+		source := map[string]interface{}{"name": "Rendoru", "fullName": "Doru"}
+		dest := Person{}
+		assert.NoError(t, Mirror(&source, &dest))
+		assert.Equal(t, "Rendoru", dest.Name)
+	})
+
+	t.Run("Ambiguous", func(t *testing.T) {
+		source := map[string]interface{}{"fullName": "Rendoru", "full_name": "Doru"}
+		dest := Person{}
+		assert.Error(t, Mirror(&source, &dest))
+	})
+
+	t.Run("CaseInsensitiveAlias", func(t *testing.T) {
+		source := map[string]interface{}{"FULLNAME": "Rendoru"}
+		dest := Person{}
+		assert.NoError(t, Mirror(&source, &dest, WithCaseInsensitiveKeys()))
+		assert.Equal(t, "Rendoru", dest.Name)
+	})
+}
+
+type EmbeddedBase struct {
+	ID   int
+	Name string
+}
+
+type EmbeddedOther struct {
+	Name string
+}
+
+type EmbeddedSource struct {
+	EmbeddedBase
+	Code string
+}
+
+type EmbeddedPointerSource struct {
+	*EmbeddedBase
+	Code string
+}
+
+type EmbeddedAmbiguousSource struct {
+	EmbeddedBase
+	EmbeddedOther
+}
+
+type embeddedHidden struct {
+	ID   int
+	Name string
+}
+
+type EmbeddedHiddenSource struct {
+	embeddedHidden
+	Code string
+}
+
+type EmbeddedHiddenPointerSource struct {
+	*embeddedHidden
+	Code string
+}
+
+type EmbeddedFlat struct {
+	ID   int
+	Name string
+	Code string
+}
+
+func TestStructToStructPromotedField(t *testing.T) {
+	t.Run("Embedded", func(t *testing.T) {
+		dest := EmbeddedFlat{}
+		if err := Mirror(&EmbeddedSource{EmbeddedBase{7, "Doru"}, "x"}, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, EmbeddedFlat{7, "Doru", "x"}, dest)
+	})
+
+	t.Run("EmbeddedPointer", func(t *testing.T) {
+		dest := EmbeddedFlat{}
+		if err := Mirror(&EmbeddedPointerSource{&EmbeddedBase{7, "Doru"}, "x"}, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, EmbeddedFlat{7, "Doru", "x"}, dest)
+	})
+
+	t.Run("NilEmbeddedPointer", func(t *testing.T) {
+		dest := EmbeddedFlat{}
+		if err := Mirror(&EmbeddedPointerSource{nil, "x"}, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, EmbeddedFlat{Code: "x"}, dest)
+	})
+
+	t.Run("Ambiguous", func(t *testing.T) {
+		dest := EmbeddedFlat{}
+		if err := Mirror(&EmbeddedAmbiguousSource{EmbeddedBase{7, "Doru"}, EmbeddedOther{"Rendoru"}}, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, EmbeddedFlat{ID: 7}, dest)
+	})
+
+	t.Run("UnexportedEmbedded", func(t *testing.T) {
+		dest := EmbeddedFlat{}
+		if err := Mirror(&EmbeddedHiddenSource{embeddedHidden{7, "Doru"}, "x"}, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, EmbeddedFlat{7, "Doru", "x"}, dest)
+	})
+
+	t.Run("UnexportedEmbeddedPointer", func(t *testing.T) {
+		dest := EmbeddedFlat{}
+		if err := SmartMirror(&EmbeddedHiddenPointerSource{&embeddedHidden{7, "Doru"}, "x"}, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, EmbeddedFlat{7, "Doru", "x"}, dest)
+	})
+
+	t.Run("EmbeddedToEmbedded", func(t *testing.T) {
+		dest := EmbeddedSource{}
+		if err := Mirror(&EmbeddedSource{EmbeddedBase{7, "Doru"}, "x"}, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, EmbeddedSource{EmbeddedBase{7, "Doru"}, "x"}, dest)
+	})
+}
@@ -0,0 +1,247 @@
+package mirror
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+type _StructField struct {
+	_FieldTag
+	index int
+	//Index sequence from the indexed struct, longer than one for promoted field
+	path []int
+}
+
+type _FieldTag struct {
+	name      string
+	named     bool
+	omitEmpty bool
+	aliases   []string
+
+	//Per field override of call option, zero value means not set
+	slicePolicy SlicePolicy
+	mergeKey    string
+	rounding    RoundingPolicy
+	format      _StringFormat
+	encoding    BytesEncoding
+	layout      string
+	unit        time.Duration
+}
+
+//Report whether tag override any call option
+func (t *_FieldTag) HasOption() bool {
+	return t.slicePolicy != 0 || t.rounding != 0 || t.format != (_StringFormat{}) || t.encoding != 0 || t.layout != "" || t.unit != 0
+}
+
+//Parse field tag into name and option using the first tag key found
+//Example : `mirror:"user_id,omitempty"`, `mirror:"name,alias=fullName|full_name"` or `json:"-"`
+//Option : `slice=replace|append|merge`, `key=ID` for merge key, `round=truncate|even|away|floor|ceil|reject`,
+//`format=f`, `precision=2`, `base=16` and `bool=yes|no` for string format, `encoding=raw|base64|base64url|hex` for byte slice,
+//`layout=2006-01-02` and `unit=ns|us|ms|s|m|h` for time and duration
+//Option value can be quoted using single quote so it can contain comma, such as `layout='Mon, 02 Jan 2006'`
+//Tag with option only such as `mirror:",omitempty"` take it's name from the next tag key in order
+//Field without explicit name will be named using the configured [NameStrategy]
+//Return false if the field should be ignored
+func _ParseTag(field reflect.StructField, config *_Config) (_FieldTag, bool) {
+	for i, key := range config.tagKeys {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		if tag == "-" {
+			return _FieldTag{}, false
+		}
+		parts := _SplitTag(tag)
+		result := _FieldTag{
+			name: strings.TrimSpace(parts[0]),
+		}
+		if result.name == "" {
+			result.name = _LookupTagName(field, config.tagKeys[i+1:])
+		}
+		result.named = result.name != ""
+		if result.name == "" {
+			result.name = config.nameStrategy(field.Name)
+		}
+		for _, option := range parts[1:] {
+			option = strings.TrimSpace(option)
+			if option == "omitempty" {
+				result.omitEmpty = true
+			} else if strings.HasPrefix(option, "alias=") {
+				for _, alias := range strings.Split(option[len("alias="):], "|") {
+					if alias = strings.TrimSpace(alias); alias != "" {
+						result.aliases = append(result.aliases, alias)
+					}
+				}
+			} else if strings.HasPrefix(option, "slice=") {
+				result.slicePolicy = _ParseSlicePolicy(option[len("slice="):])
+			} else if strings.HasPrefix(option, "round=") {
+				result.rounding = _ParseRoundingPolicy(option[len("round="):])
+			} else if result.format.ParseOption(option) {
+				continue
+			} else if strings.HasPrefix(option, "encoding=") {
+				result.encoding = _ParseBytesEncoding(option[len("encoding="):])
+			} else if strings.HasPrefix(option, "layout=") {
+				result.layout = _UnquoteTagValue(option[len("layout="):])
+			} else if strings.HasPrefix(option, "unit=") {
+				result.unit = _ParseTimeUnit(option[len("unit="):])
+			} else if strings.HasPrefix(option, "key=") {
+				result.mergeKey = strings.TrimSpace(option[len("key="):])
+				if result.slicePolicy == 0 {
+					result.slicePolicy = SliceMerge
+				}
+			}
+		}
+		return result, true
+	}
+	return _FieldTag{name: config.nameStrategy(field.Name)}, true
+}
+
+//Split tag by comma, comma inside single quoted option value is kept
+//Quote only start right after `=` so apostrophe elsewhere is taken as is
+func _SplitTag(tag string) []string {
+	parts := make([]string, 0, strings.Count(tag, ",")+1)
+	start := 0
+	quoted := false
+	for i := 0; i < len(tag); i++ {
+		switch tag[i] {
+		case '\'':
+			if quoted {
+				quoted = false
+			} else if i > 0 && tag[i-1] == '=' {
+				quoted = true
+			}
+		case ',':
+			if !quoted {
+				parts = append(parts, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, tag[start:])
+}
+
+//Remove single quote surrounding option value
+func _UnquoteTagValue(value string) string {
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+//Get the first non empty name from the given tag keys
+func _LookupTagName(field reflect.StructField, keys []string) string {
+	for _, key := range keys {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok || tag == "-" {
+			continue
+		}
+		if name := strings.TrimSpace(strings.SplitN(tag, ",", 2)[0]); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+//Get all exported and non ignored field of a struct type
+func _GetStructFields(structType reflect.Type, config *_Config) []_StructField {
+	numField := structType.NumField()
+	fields := make([]_StructField, 0, numField)
+	for i := 0; i < numField; i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag, ok := _ParseTag(field, config)
+		if !ok {
+			continue
+		}
+		fields = append(fields, _StructField{
+			_FieldTag: tag,
+			index:     i,
+			path:      []int{i},
+		})
+	}
+	return fields
+}
+
+//Get field of struct type indexed by it's mirror name
+//Field promoted from embedded struct without tag name is indexed too, following Go selector rule :
+//the shallowest field win and name that is ambiguous at the same depth is dropped
+//Field is indexed by it's Go name instead when byGoName is true
+func _GetStructFieldIndex(structType reflect.Type, config *_Config, byGoName bool) map[string]_StructField {
+	type embedded struct {
+		structType reflect.Type
+		path       []int
+	}
+	indexes := map[string]_StructField{}
+	hidden := map[string]bool{}
+	visited := map[reflect.Type]bool{structType: true}
+	level := []embedded{{structType: structType}}
+	for depth := 0; len(level) > 0; depth++ {
+		var next []embedded
+		found := map[string]_StructField{}
+		count := map[string]int{}
+		for _, current := range level {
+			for _, field := range _GetStructFields(current.structType, config) {
+				field.path = append(append(make([]int, 0, len(current.path)+1), current.path...), field.index)
+				name := field.name
+				if byGoName {
+					name = current.structType.Field(field.index).Name
+				}
+				if _, ok := found[name]; !ok {
+					found[name] = field
+				}
+				count[name]++
+			}
+			//Unexported embedded struct is walked too since it's exported field is promoted, like encoding/json
+			for i := 0; i < current.structType.NumField(); i++ {
+				structField := current.structType.Field(i)
+				if !structField.Anonymous {
+					continue
+				}
+				fieldType := structField.Type
+				if fieldType.Kind() == reflect.Ptr {
+					fieldType = fieldType.Elem()
+				}
+				if fieldType.Kind() != reflect.Struct || visited[fieldType] {
+					continue
+				}
+				if tag, ok := _ParseTag(structField, config); !ok || tag.named {
+					continue
+				}
+				visited[fieldType] = true
+				path := append(append(make([]int, 0, len(current.path)+1), current.path...), i)
+				next = append(next, embedded{structType: fieldType, path: path})
+			}
+		}
+		for name, field := range found {
+			if _, ok := indexes[name]; ok || hidden[name] {
+				continue
+			}
+			//Direct field keep the first one found, as before embedded field were indexed
+			if depth > 0 && count[name] > 1 {
+				hidden[name] = true
+				continue
+			}
+			indexes[name] = field
+		}
+		level = next
+	}
+	return indexes
+}
+
+//Get field of struct value by index sequence
+//Return false if an embedded pointer along the sequence is nil
+func _FieldByPath(value reflect.Value, path []int) (reflect.Value, bool) {
+	for i, index := range path {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return reflect.Value{}, false
+			}
+			value = value.Elem()
+		}
+		value = value.Field(index)
+	}
+	return value, true
+}
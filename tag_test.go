@@ -0,0 +1,169 @@
+package mirror
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TaggedUser struct {
+	UserID   int    `mirror:"user_id"`
+	Name     string `mirror:"name,omitempty"`
+	Password string `mirror:"-"`
+	Email    string
+}
+
+type TaggedAccount struct {
+	ID       int    `mirror:"user_id"`
+	FullName string `mirror:"name"`
+	Password string
+	Email    string
+}
+
+func TestTagStructToMap(t *testing.T) {
+	source := TaggedUser{
+		UserID:   1,
+		Password: "secret",
+		Email:    "doru@mail.com",
+	}
+	dest := map[string]interface{}{}
+	if err := Mirror(&source, &dest); err != nil {
+		t.Error(err)
+	}
+	assert.Equal(t, map[string]interface{}{
+		"user_id": 1,
+		"Email":   "doru@mail.com",
+	}, dest)
+}
+
+func TestTagMapToStruct(t *testing.T) {
+	source := map[string]interface{}{
+		"user_id":  1,
+		"name":     "Rendoru",
+		"Password": "secret",
+		"Email":    "doru@mail.com",
+	}
+	dest := TaggedUser{}
+	if err := Mirror(&source, &dest); err != nil {
+		t.Error(err)
+	}
+	assert.Equal(t, TaggedUser{
+		UserID: 1,
+		Name:   "Rendoru",
+		Email:  "doru@mail.com",
+	}, dest)
+}
+
+func TestTagStructToStruct(t *testing.T) {
+	source := TaggedUser{
+		UserID:   1,
+		Name:     "Rendoru",
+		Password: "secret",
+		Email:    "doru@mail.com",
+	}
+	dest := TaggedAccount{}
+	if err := Mirror(&source, &dest); err != nil {
+		t.Error(err)
+	}
+	assert.Equal(t, TaggedAccount{
+		ID:       1,
+		FullName: "Rendoru",
+		Email:    "doru@mail.com",
+	}, dest)
+
+	t.Run("OmitEmptyKeepDestination", func(t *testing.T) {
+		source := TaggedUser{UserID: 2}
+		dest := TaggedAccount{FullName: "Doru"}
+		if err := Mirror(&source, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, 2, dest.ID)
+		assert.Equal(t, "Doru", dest.FullName)
+	})
+}
+
+type JsonTaggedUser struct {
+	UserID   int    `json:"user_id"`
+	Name     string `mapstructure:"full_name"`
+	Password string `json:"-"`
+	Email    string `mirror:"mail" json:"email"`
+}
+
+func TestTagFallback(t *testing.T) {
+	source := JsonTaggedUser{
+		UserID:   1,
+		Name:     "Rendoru",
+		Password: "secret",
+		Email:    "doru@mail.com",
+	}
+
+	t.Run("DefaultLookupOrder", func(t *testing.T) {
+		dest := map[string]interface{}{}
+		if err := Mirror(&source, &dest); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, map[string]interface{}{
+			"user_id":   1,
+			"full_name": "Rendoru",
+			"mail":      "doru@mail.com",
+		}, dest)
+	})
+
+	t.Run("CustomLookupOrder", func(t *testing.T) {
+		dest := map[string]interface{}{}
+		if err := Mirror(&source, &dest, WithTagKeys("json")); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, map[string]interface{}{
+			"user_id": 1,
+			"Name":    "Rendoru",
+			"email":   "doru@mail.com",
+		}, dest)
+	})
+}
+
+type TaggedOptionOnly struct {
+	UserID int    `json:"user_id" mirror:",omitempty"`
+	Name   string `mirror:",omitempty" db:"full_name"`
+	Email  string `mirror:",omitempty"`
+}
+
+func TestTagOptionOnlyName(t *testing.T) {
+	source := TaggedOptionOnly{
+		UserID: 1,
+		Name:   "Rendoru",
+	}
+	dest := map[string]interface{}{}
+	if err := Mirror(&source, &dest); err != nil {
+		t.Error(err)
+	}
+	assert.Equal(t, map[string]interface{}{
+		"user_id":   1,
+		"full_name": "Rendoru",
+	}, dest)
+}
+
+type JSONTaggedUser struct {
+	UserID int    `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+type UntaggedUser struct {
+	UserID int
+	Name   string
+}
+
+func TestTagStructToUntaggedStruct(t *testing.T) {
+	source := JSONTaggedUser{UserID: 7, Name: "Doru"}
+	dest := UntaggedUser{}
+	if err := Mirror(&source, &dest); err != nil {
+		t.Error(err)
+	}
+	assert.Equal(t, UntaggedUser{UserID: 7, Name: "Doru"}, dest)
+
+	back := JSONTaggedUser{}
+	if err := Mirror(&dest, &back); err != nil {
+		t.Error(err)
+	}
+	assert.Equal(t, source, back)
+}
@@ -0,0 +1,221 @@
+package mirror
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	_TimeType     = reflect.TypeOf(time.Time{})
+	_DurationType = reflect.TypeOf(time.Duration(0))
+)
+
+//Default layout used to parse time, the first one is also used to format time
+var _DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+func _ParseTimeUnit(raw string) time.Duration {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "ns":
+		return time.Nanosecond
+	case "us", "µs":
+		return time.Microsecond
+	case "ms":
+		return time.Millisecond
+	case "s":
+		return time.Second
+	case "m":
+		return time.Minute
+	case "h":
+		return time.Hour
+	}
+	return 0
+}
+
+//Get unit of number mirrored from and into time, field tag take precedence over fallback
+func (ctx *_MirrorContext) TimeUnit(fallback time.Duration) time.Duration {
+	if tag := ctx.InheritedTag(); tag != nil && tag.unit != 0 {
+		return tag.unit
+	}
+	return fallback
+}
+
+//Get layout used to parse and format time, field tag take precedence over call option
+func (ctx *_MirrorContext) TimeLayouts() []string {
+	if tag := ctx.InheritedTag(); tag != nil && tag.layout != "" {
+		return []string{tag.layout}
+	}
+	return ctx.config.timeLayouts
+}
+
+//Get location time is normalized into, UTC is used if it's not configured
+func (ctx *_MirrorContext) TimeLocation() *time.Location {
+	if ctx.config.timeLocation != nil {
+		return ctx.config.timeLocation
+	}
+	return time.UTC
+}
+
+//Handle conversion from and into time.Time
+//Time is parsed from string using the configured layout or from Unix time number
+func _HandleTime(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	if dest.Type() != _TimeType {
+		return _HandleFromTime(source, dest, sourceKind, destKind, ctx)
+	}
+	unit := ctx.TimeUnit(ctx.config.timeUnit)
+	var value time.Time
+	switch sourceKind {
+	case reflect.String:
+		rawString := strings.TrimSpace(source.String())
+		var err error
+		for _, layout := range ctx.TimeLayouts() {
+			if value, err = time.ParseInLocation(layout, rawString, ctx.TimeLocation()); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return ctx.NewError(source, dest, "Time", _NewParseError(err))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = _UnixTime(source.Int(), unit)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number := source.Uint()
+		if number > math.MaxInt64 {
+			return ctx.NewError(source, dest, "Time", _NewOverflowError(number, dest))
+		}
+		value = _UnixTime(int64(number), unit)
+	case reflect.Float32, reflect.Float64:
+		number := source.Float() * (float64(unit) / float64(time.Second))
+		if math.IsNaN(number) || math.IsInf(number, 0) || number < -(1<<63) || number >= 1<<63 {
+			return ctx.NewError(source, dest, "Time", _NewOverflowError(source.Float(), dest))
+		}
+		seconds, fraction := math.Modf(number)
+		value = time.Unix(int64(seconds), int64(math.Round(fraction*float64(time.Second))))
+	default:
+		return ctx.NewError(source, dest, "Time", ErrTypeMismatch)
+	}
+	dest.Set(reflect.ValueOf(value.In(ctx.TimeLocation())))
+	return nil
+}
+
+//Convert Unix time number in unit into time
+func _UnixTime(number int64, unit time.Duration) time.Time {
+	if unit%time.Second == 0 {
+		return time.Unix(number*int64(unit/time.Second), 0)
+	}
+	perSecond := int64(time.Second / unit)
+	return time.Unix(number/perSecond, number%perSecond*int64(unit))
+}
+
+//Handle conversion from time.Time into string or Unix time number
+//Time mirrored into empty interface is formatted as string so it's safe to be encoded
+func _HandleFromTime(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	value := source.Interface().(time.Time).In(ctx.TimeLocation())
+	unit := ctx.TimeUnit(ctx.config.timeUnit)
+	switch destKind {
+	case reflect.String:
+		dest.SetString(value.Format(ctx.TimeLayouts()[0]))
+	case reflect.Interface:
+		if dest.NumMethod() > 0 {
+			return _HandleKind(source, dest, sourceKind, destKind, ctx)
+		}
+		dest.Set(reflect.ValueOf(value.Format(ctx.TimeLayouts()[0])))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return _SetInt(source, dest, _UnixNumber(value, unit), ctx)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number := _UnixNumber(value, unit)
+		if number < 0 && !ctx.config.wrapNumbers {
+			return ctx.NewError(source, dest, "Time", _NewOverflowError(number, dest))
+		}
+		return _SetUint(source, dest, uint64(number), ctx)
+	case reflect.Float32, reflect.Float64:
+		seconds := float64(value.Unix()) + float64(value.Nanosecond())/float64(time.Second)
+		return _SetFloat(source, dest, seconds*(float64(time.Second)/float64(unit)), ctx)
+	default:
+		return _HandleKind(source, dest, sourceKind, destKind, ctx)
+	}
+	return nil
+}
+
+//Convert time into Unix time number in unit, remainder is truncated
+func _UnixNumber(value time.Time, unit time.Duration) int64 {
+	if unit%time.Second == 0 {
+		return value.Unix() / int64(unit/time.Second)
+	}
+	perSecond := int64(time.Second / unit)
+	return value.Unix()*perSecond + int64(value.Nanosecond())/int64(unit)
+}
+
+//Handle conversion from and into time.Duration
+//Duration is parsed from string such as "1h30m" or from number in the configured unit, nanosecond by default
+func _HandleDuration(source, dest reflect.Value, sourceKind, destKind reflect.Kind, ctx *_MirrorContext) error {
+	unit := ctx.TimeUnit(time.Nanosecond)
+	if dest.Type() != _DurationType {
+		duration := time.Duration(source.Int())
+		switch destKind {
+		case reflect.String:
+			dest.SetString(duration.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return _SetInt(source, dest, int64(duration/unit), ctx)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if duration < 0 && !ctx.config.wrapNumbers {
+				return ctx.NewError(source, dest, "Duration", _NewOverflowError(duration, dest))
+			}
+			return _SetUint(source, dest, uint64(duration/unit), ctx)
+		case reflect.Float32, reflect.Float64:
+			return _SetFloat(source, dest, float64(duration)/float64(unit), ctx)
+		default:
+			return _HandleKind(source, dest, sourceKind, destKind, ctx)
+		}
+		return nil
+	}
+	switch sourceKind {
+	case reflect.String:
+		rawString := strings.TrimSpace(source.String())
+		if duration, err := time.ParseDuration(rawString); err == nil {
+			dest.SetInt(int64(duration))
+			return nil
+		}
+		integer, err := _ParseIntString(rawString, ctx.config.numberSyntax)
+		if err != nil {
+			return ctx.NewError(source, dest, "Duration", fmt.Errorf("%w, %q is not a duration", ErrParse, rawString))
+		}
+		return _SetDuration(source, dest, integer, unit, ctx)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return _SetDuration(source, dest, source.Int(), unit, ctx)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		integer := source.Uint()
+		if integer > math.MaxInt64 && !ctx.config.wrapNumbers {
+			return ctx.NewError(source, dest, "Duration", _NewOverflowError(integer, dest))
+		}
+		return _SetDuration(source, dest, int64(integer), unit, ctx)
+	case reflect.Float32, reflect.Float64:
+		number := source.Float() * float64(unit)
+		if !ctx.config.wrapNumbers {
+			if err := _CheckFloatRange(number, -(1 << 63), 1<<63, dest); err != nil {
+				return ctx.NewError(source, dest, "Duration", err)
+			}
+		}
+		dest.SetInt(int64(number))
+	default:
+		return ctx.NewError(source, dest, "Duration", ErrTypeMismatch)
+	}
+	return nil
+}
+
+//Set number in unit into duration dest, duration that overflow is an error unless wrapping is allowed
+func _SetDuration(source, dest reflect.Value, number int64, unit time.Duration, ctx *_MirrorContext) error {
+	limit := int64(math.MaxInt64 / unit)
+	if (number > limit || number < -limit) && !ctx.config.wrapNumbers {
+		return ctx.NewError(source, dest, "Duration", _NewOverflowError(number, dest))
+	}
+	dest.SetInt(number * int64(unit))
+	return nil
+}
@@ -0,0 +1,199 @@
+package mirror
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeFromString(t *testing.T) {
+	expected := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		name   string
+		source string
+		result time.Time
+	}{
+		{"RFC3339", "2024-03-01T10:30:00Z", expected},
+		{"Offset", "2024-03-01T17:30:00+07:00", expected},
+		{"Nano", " 2024-03-01T10:30:00.5Z ", expected.Add(500 * time.Millisecond)},
+		{"DateTime", "2024-03-01 10:30:00", expected},
+		{"Date", "2024-03-01", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := time.Time{}
+			assert.NoError(t, SmartMirror(&testCase.source, &dest))
+			assert.True(t, testCase.result.Equal(dest), "got %v", dest)
+			assert.Equal(t, time.UTC, dest.Location())
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		source := "yesterday"
+		dest := time.Time{}
+		assert.True(t, errors.Is(SmartMirror(&source, &dest), ErrParse))
+	})
+
+	t.Run("Layout", func(t *testing.T) {
+		source := "01/03/2024"
+		dest := time.Time{}
+		assert.NoError(t, SmartMirror(&source, &dest, WithTimeLayouts("02/01/2006")))
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), dest)
+	})
+
+	t.Run("Location", func(t *testing.T) {
+		location := time.FixedZone("WIB", 7*60*60)
+		source := "2024-03-01 17:30:00"
+		dest := time.Time{}
+		assert.NoError(t, SmartMirror(&source, &dest, WithTimeLocation(location)))
+		assert.True(t, expected.Equal(dest))
+		assert.Equal(t, location, dest.Location())
+	})
+
+	t.Run("NotSmart", func(t *testing.T) {
+		source := map[string]interface{}{"At": "2024-03-01T10:30:00Z"}
+		dest := struct{ At time.Time }{}
+		assert.True(t, errors.Is(Mirror(&source, &dest), ErrTypeMismatch))
+		raw := map[string]interface{}{}
+		assert.True(t, errors.Is(Mirror(&raw, &dest.At), ErrTypeMismatch))
+	})
+}
+
+func TestTimeFromNumber(t *testing.T) {
+	expected := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		name    string
+		source  interface{}
+		options []Option
+		result  time.Time
+	}{
+		{"Seconds", expected.Unix(), nil, expected},
+		{"Uint", uint64(expected.Unix()), nil, expected},
+		{"Float", float64(expected.Unix()) + 0.25, nil, expected.Add(250 * time.Millisecond)},
+		{"Millis", expected.UnixNano()/int64(time.Millisecond) + 5, []Option{WithTimeUnit(time.Millisecond)}, expected.Add(5 * time.Millisecond)},
+		{"Negative", int64(-1), nil, time.Unix(-1, 0)},
+		{"NegativeMillis", int64(-1500), []Option{WithTimeUnit(time.Millisecond)}, time.Unix(-2, 5e8)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := time.Time{}
+			assert.NoError(t, SmartMirror(&testCase.source, &dest, testCase.options...))
+			assert.True(t, testCase.result.Equal(dest), "got %v", dest)
+		})
+	}
+}
+
+func TestTimeToScalar(t *testing.T) {
+	type Event struct {
+		At       time.Time
+		Date     time.Time `mirror:",layout=2006-01-02"`
+		Millis   time.Time `mirror:",unit=ms"`
+		Seconds  time.Time
+		Interval time.Duration
+	}
+	at := time.Date(2024, 3, 1, 10, 30, 0, 5e8, time.FixedZone("WIB", 7*60*60))
+	source := Event{at, at, at, at, 90 * time.Minute}
+
+	t.Run("Map", func(t *testing.T) {
+		dest := map[string]interface{}{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, map[string]interface{}{
+			"At":       "2024-03-01T03:30:00.5Z",
+			"Date":     "2024-03-01",
+			"Millis":   "2024-03-01T03:30:00.5Z",
+			"Seconds":  "2024-03-01T03:30:00.5Z",
+			"Interval": 90 * time.Minute,
+		}, dest)
+
+		raw := map[string]interface{}{}
+		assert.NoError(t, Mirror(&source, &raw))
+		assert.Equal(t, at, raw["At"])
+	})
+
+	t.Run("Number", func(t *testing.T) {
+		dest := struct {
+			Millis   int64
+			Seconds  float64
+			Interval string
+		}{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, at.UnixNano()/int64(time.Millisecond), dest.Millis)
+		assert.Equal(t, float64(at.Unix())+0.5, dest.Seconds)
+		assert.Equal(t, "1h30m0s", dest.Interval)
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		dest := map[string]string{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		result := Event{}
+		assert.NoError(t, SmartMirror(&dest, &result))
+		assert.True(t, at.Equal(result.At))
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), result.Date)
+		assert.Equal(t, source.Interval, result.Interval)
+	})
+}
+
+func TestTimeLayoutWithComma(t *testing.T) {
+	type Event struct {
+		At    time.Time `mirror:"at,layout='Mon, 02 Jan 2006 15:04:05 MST',omitempty"`
+		Label string    `mirror:"label,alias=it's"`
+	}
+	source := map[string]interface{}{"at": "Fri, 01 Mar 2024 03:30:00 UTC", "it's": "launch"}
+	dest := Event{}
+	assert.NoError(t, SmartMirror(&source, &dest))
+	assert.True(t, time.Date(2024, 3, 1, 3, 30, 0, 0, time.UTC).Equal(dest.At), "got %v", dest.At)
+	assert.Equal(t, "launch", dest.Label)
+
+	result := map[string]interface{}{}
+	assert.NoError(t, SmartMirror(&dest, &result))
+	assert.Equal(t, "Fri, 01 Mar 2024 03:30:00 UTC", result["at"])
+
+	assert.Equal(t, []string{"at", "layout='a,b'", "omitempty"}, _SplitTag("at,layout='a,b',omitempty"))
+	assert.Equal(t, []string{"at", "alias=it's", "x"}, _SplitTag("at,alias=it's,x"))
+}
+
+func TestDuration(t *testing.T) {
+	type Config struct {
+		Timeout  time.Duration
+		Interval time.Duration `mirror:",unit=s"`
+		Delay    time.Duration `mirror:",unit=ms"`
+	}
+	testCases := []struct {
+		name   string
+		source map[string]interface{}
+		result Config
+	}{
+		{"String", map[string]interface{}{"Timeout": "1h30m", "Interval": " 2s ", "Delay": "1.5s"}, Config{90 * time.Minute, 2 * time.Second, 1500 * time.Millisecond}},
+		{"Number", map[string]interface{}{"Timeout": 1000, "Interval": 30, "Delay": uint8(250)}, Config{1000, 30 * time.Second, 250 * time.Millisecond}},
+		{"NumberString", map[string]interface{}{"Timeout": "1000", "Interval": "30", "Delay": "250"}, Config{1000, 30 * time.Second, 250 * time.Millisecond}},
+		{"Float", map[string]interface{}{"Timeout": 1e3, "Interval": 1.5, "Delay": 0.5}, Config{1000, 1500 * time.Millisecond, 500 * time.Microsecond}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dest := Config{}
+			assert.NoError(t, SmartMirror(&testCase.source, &dest))
+			assert.Equal(t, testCase.result, dest)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		source := "soon"
+		dest := time.Duration(0)
+		assert.True(t, errors.Is(SmartMirror(&source, &dest), ErrParse))
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		source := map[string]interface{}{"Interval": int64(1) << 40}
+		dest := Config{}
+		assert.True(t, errors.Is(SmartMirror(&source, &dest), ErrOverflow))
+	})
+
+	t.Run("ToNumber", func(t *testing.T) {
+		source := Config{Interval: 90 * time.Second, Delay: 1500 * time.Millisecond}
+		dest := map[string]int{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, map[string]int{"Timeout": 0, "Interval": 90, "Delay": 1500}, dest)
+	})
+}
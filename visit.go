@@ -0,0 +1,82 @@
+package mirror
+
+import "reflect"
+
+//Identify a source value that has been mirrored into a destination type
+type _VisitKey struct {
+	pointer    uintptr
+	length     int
+	sourceType reflect.Type
+	destType   reflect.Type
+}
+
+type _VisitEntry struct {
+	key  _VisitKey
+	dest reflect.Value
+}
+
+const _VisitedListSize = 8
+
+//Create visit key for source value
+//Return false if source can't be identified by it's address
+func _NewVisitKey(source reflect.Value, destType reflect.Type) (_VisitKey, bool) {
+	key := _VisitKey{
+		sourceType: source.Type(),
+		destType:   destType,
+	}
+	switch {
+	case source.Kind() == reflect.Map:
+		key.pointer = source.Pointer()
+	case source.Kind() == reflect.Slice:
+		//Empty slice may point to the same zero sized allocation
+		if source.Len() == 0 {
+			return key, false
+		}
+		key.pointer = source.Pointer()
+		key.length = source.Len()
+	case source.CanAddr():
+		key.pointer = source.UnsafeAddr()
+	default:
+		return key, false
+	}
+	return key, key.pointer != 0
+}
+
+//Get destination previously created for the same source
+//It's used to stop cycle and to keep shared reference shared
+func (ctx *_MirrorContext) Visited(key _VisitKey) (reflect.Value, bool) {
+	if ctx.rootDest.IsValid() && ctx.RootKey() == key {
+		return ctx.rootDest.Addr(), true
+	}
+	for _, entry := range ctx.visitedList {
+		if entry.key == key {
+			return entry.dest, true
+		}
+	}
+	value, ok := ctx.visited[key]
+	return value, ok
+}
+
+//Get visit key of pointer to the root source
+//It's computed on first use since most call never reach a pointer
+func (ctx *_MirrorContext) RootKey() _VisitKey {
+	if !ctx.rootKeySet {
+		//Root that can't be identified keep zero pointer which never match a tracked key
+		ctx.rootKey, _ = _NewVisitKey(ctx.root, reflect.PtrTo(ctx.rootDest.Type()))
+		ctx.rootKeySet = true
+	}
+	return ctx.rootKey
+}
+
+//Remember destination created for a source, must be called before mirroring it's content
+//Few first entry is kept in a list since most value only contain a handful of pointer
+func (ctx *_MirrorContext) Visit(key _VisitKey, dest reflect.Value) {
+	if len(ctx.visitedList) < _VisitedListSize {
+		ctx.visitedList = append(ctx.visitedList, _VisitEntry{key, dest})
+		return
+	}
+	if ctx.visited == nil {
+		ctx.visited = make(map[_VisitKey]reflect.Value)
+	}
+	ctx.visited[key] = dest
+}
@@ -0,0 +1,174 @@
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TreeNode struct {
+	Name     string
+	Parent   *TreeNode
+	Children []*TreeNode
+}
+
+type OtherTreeNode struct {
+	Name     string
+	Parent   *OtherTreeNode
+	Children []*OtherTreeNode
+}
+
+func _GetTree() *TreeNode {
+	root := &TreeNode{Name: "Root"}
+	childA := &TreeNode{Name: "A", Parent: root}
+	childB := &TreeNode{Name: "B", Parent: root}
+	root.Children = []*TreeNode{childA, childB}
+	return root
+}
+
+func TestCycleDifferentType(t *testing.T) {
+	root := _GetTree()
+	dest := OtherTreeNode{}
+	assert.NoError(t, Mirror(root, &dest))
+	assert.Equal(t, "Root", dest.Name)
+	if assert.Len(t, dest.Children, 2) {
+		assert.Equal(t, "A", dest.Children[0].Name)
+		assert.True(t, dest.Children[0].Parent == &dest)
+		assert.True(t, dest.Children[1].Parent == &dest)
+	}
+}
+
+func TestCycleDeepCopy(t *testing.T) {
+	root := _GetTree()
+	result, err := Clone(root)
+	if !assert.NoError(t, err) {
+		return
+	}
+	clone := result.(*TreeNode)
+	assert.False(t, clone == root)
+	assert.True(t, clone.Children[0].Parent == clone)
+	assert.True(t, clone.Children[1].Parent == clone)
+	assert.False(t, clone.Children[0] == root.Children[0])
+}
+
+func TestSharedReferenceDeepCopy(t *testing.T) {
+	type Shared struct {
+		First  *PrimitiveStruct
+		Second *PrimitiveStruct
+		Tags   []string
+		Alias  []string
+		Labels map[string]int
+		Same   map[string]int
+	}
+	child := &PrimitiveStruct{"Doru", 1}
+	tags := []string{"A"}
+	labels := map[string]int{"A": 1}
+	source := Shared{child, child, tags, tags, labels, labels}
+
+	dest := Shared{}
+	assert.NoError(t, DeepMirror(&source, &dest))
+	assert.True(t, dest.First == dest.Second)
+	assert.False(t, dest.First == child)
+	dest.Tags[0] = "B"
+	assert.Equal(t, "B", dest.Alias[0])
+	assert.Equal(t, "A", tags[0])
+	dest.Labels["A"] = 2
+	assert.Equal(t, 2, dest.Same["A"])
+	assert.Equal(t, 1, labels["A"])
+}
+
+func TestSharedReferenceReusedDest(t *testing.T) {
+	type Source struct {
+		A map[string]int
+		B map[string]int
+		C []int
+		D []int
+	}
+	type Dest struct {
+		A map[string]int64
+		B map[string]int64
+		C []int64
+		D []int64
+	}
+
+	t.Run("ReusedMap", func(t *testing.T) {
+		labels := map[string]int{"a": 1}
+		source := Source{A: labels, B: labels}
+		dest := Dest{A: map[string]int64{}, B: map[string]int64{"b": 2}}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, map[string]int64{"a": 1}, dest.A)
+		assert.Equal(t, map[string]int64{"a": 1, "b": 2}, dest.B)
+	})
+
+	t.Run("ReusedMapNotShared", func(t *testing.T) {
+		labels := map[string]int{"a": 1}
+		source := Source{A: labels, B: labels}
+		dest := Dest{A: map[string]int64{"b": 2}}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, map[string]int64{"a": 1, "b": 2}, dest.A)
+		assert.Equal(t, map[string]int64{"a": 1}, dest.B)
+	})
+
+	t.Run("EmptySlice", func(t *testing.T) {
+		source := Source{C: []int{}, D: make([]int, 0)}
+		dest := Dest{C: []int64{1}}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, []int64{1}, dest.C)
+		assert.Empty(t, dest.D)
+	})
+
+	t.Run("SharedSlice", func(t *testing.T) {
+		tags := []int{1}
+		source := Source{C: tags, D: tags}
+		dest := Dest{}
+		assert.NoError(t, SmartMirror(&source, &dest))
+		assert.Equal(t, []int64{1}, dest.D)
+		dest.C[0] = 2
+		assert.Equal(t, int64(2), dest.D[0])
+	})
+}
+
+func TestCycleMapToStruct(t *testing.T) {
+	source := map[string]interface{}{
+		"Name": "Root",
+	}
+	source["Parent"] = source
+	dest := OtherTreeNode{}
+	assert.NoError(t, Mirror(&source, &dest, WithBestEffort()))
+	assert.Equal(t, "Root", dest.Name)
+	assert.True(t, dest.Parent == &dest)
+}
+
+type SelfMap map[string]SelfMap
+
+type OtherSelfMap map[string]OtherSelfMap
+
+type SelfSlice []SelfSlice
+
+type OtherSelfSlice []OtherSelfSlice
+
+func TestCycleMapToMap(t *testing.T) {
+	source := SelfMap{}
+	source["self"] = source
+	dest := OtherSelfMap{}
+	if !assert.NoError(t, Mirror(&source, &dest)) {
+		return
+	}
+	if assert.Len(t, dest, 1) {
+		assert.Equal(t, reflect.ValueOf(dest).Pointer(), reflect.ValueOf(dest["self"]).Pointer())
+	}
+}
+
+func TestCycleSlice(t *testing.T) {
+	source := SelfSlice{nil, nil}
+	source[0] = source
+	dest := OtherSelfSlice{}
+	if !assert.NoError(t, Mirror(&source, &dest)) {
+		return
+	}
+	if assert.Len(t, dest, 2) && assert.Len(t, dest[0], 2) {
+		assert.Equal(t, reflect.ValueOf(dest).Pointer(), reflect.ValueOf(dest[0]).Pointer())
+		assert.Nil(t, dest[1])
+	}
+}